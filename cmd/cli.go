@@ -1,38 +1,117 @@
 package cmd
 
 import (
+	"flag"
 	"fmt"
 
+	"github.com/dkaslovsky/baseconv/cmd/completion"
+	"github.com/dkaslovsky/baseconv/cmd/config"
 	"github.com/dkaslovsky/baseconv/cmd/decode"
 	"github.com/dkaslovsky/baseconv/cmd/encode"
+	"github.com/dkaslovsky/baseconv/cmd/hashids"
+	"github.com/dkaslovsky/baseconv/cmd/info"
+	"github.com/dkaslovsky/baseconv/cmd/ksuid"
+	"github.com/dkaslovsky/baseconv/cmd/repl"
+	"github.com/dkaslovsky/baseconv/cmd/serve"
+	"github.com/dkaslovsky/baseconv/cmd/shortener"
+	"github.com/dkaslovsky/baseconv/cmd/snowflake"
+	"github.com/dkaslovsky/baseconv/cmd/sqids"
+	"github.com/dkaslovsky/baseconv/cmd/table"
+	"github.com/dkaslovsky/baseconv/cmd/ulid"
+	"github.com/dkaslovsky/baseconv/cmd/uuid"
 )
 
+// command describes a (sub)command of the top level command
+type command struct {
+	name  string
+	usage string
+	flags func() *flag.FlagSet
+	args  []string
+	run   func(args []string) error
+}
+
+// commands lists the (sub)commands of the top level command with the specified name and version
+func commands(name string, version string) []command {
+	return []command{
+		{name: "encode", usage: "encodes a base 10 integer in a new base", flags: encode.Flags, run: encode.Run},
+		{name: "decode", usage: "decodes a string representation of a base 10 integer", flags: decode.Flags, run: decode.Run},
+		{name: "info", usage: "describes the capacity of an encoding", flags: info.Flags, run: info.Run},
+		{name: "table", usage: "prints a base 10 integer in multiple bases", flags: table.Flags, run: table.Run},
+		{name: "hashids", usage: "encodes lists of base 10 integers as Hashids-compatible strings", flags: hashids.Flags, args: hashids.Actions, run: hashids.Run},
+		{name: "sqids", usage: "encodes lists of base 10 integers as Sqids", flags: sqids.Flags, args: sqids.Actions, run: sqids.Run},
+		{name: "uuid", usage: "encodes UUIDs as fixed-width strings", flags: uuid.Flags, args: uuid.Actions, run: uuid.Run},
+		{name: "ulid", usage: "generates and inspects ULIDs", flags: ulid.Flags, args: ulid.Actions, run: ulid.Run},
+		{name: "ksuid", usage: "generates and inspects KSUIDs", flags: ksuid.Flags, args: ksuid.Actions, run: ksuid.Run},
+		{name: "snowflake", usage: "generates and inspects Snowflake IDs", flags: snowflake.Flags, args: snowflake.Actions, run: snowflake.Run},
+		{name: "repl", usage: "starts an interactive encoding and decoding session", flags: repl.Flags, run: func(args []string) error {
+			return repl.Run(args, func(replArgs []string) error {
+				return Run(name, version, append([]string{name}, replArgs...))
+			})
+		}},
+		{name: "serve", usage: "serves encoding and decoding over HTTP", flags: serve.Flags, run: serve.Run},
+		{name: "shortener", usage: "runs a URL shortener issuing base 62 slugs", flags: shortener.Flags, run: shortener.Run},
+		{name: "config", usage: "displays default flag values from the environment and config file", flags: config.Flags, args: []string{"show"}, run: config.Run},
+		{name: "completion", usage: "generates a shell completion script", args: completion.Shells, run: func(args []string) error {
+			return completion.Run(args, name, topLevelFlags(name), completionCommands(commands(name, version)))
+		}},
+	}
+}
+
 // Run executes the top level command
 func Run(name string, version string, cliArgs []string) error {
+	cmds := commands(name, version)
+
 	if len(cliArgs) <= 1 {
-		printUsage(name)
+		printUsage(name, cmds)
 		return nil
 	}
 
 	cmd, args := cliArgs[1], cliArgs[2:]
 
 	switch cmd {
-	case "encode":
-		return encode.Run(args)
-	case "decode":
-		return decode.Run(args)
 	case "-help", "-h":
-		printUsage(name)
+		printUsage(name, cmds)
 		return nil
 	case "-version", "-v":
 		printVersion(name, version)
 		return nil
-	default:
-		return fmt.Errorf("unknown command %s", cmd)
 	}
+
+	for _, c := range cmds {
+		if c.name == cmd {
+			return c.run(args)
+		}
+	}
+	return fmt.Errorf("unknown command %s", cmd)
+}
+
+// topLevelFlags returns the flags of the top level command
+func topLevelFlags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Bool("h", false, fmt.Sprintf("help for %s", name))
+	flags.Bool("help", false, fmt.Sprintf("help for %s", name))
+	flags.Bool("v", false, fmt.Sprintf("version for %s", name))
+	flags.Bool("version", false, fmt.Sprintf("version for %s", name))
+	return flags
 }
 
-func printUsage(name string) {
+func completionCommands(commands []command) []completion.Command {
+	cmds := []completion.Command{}
+	for _, c := range commands {
+		cc := completion.Command{
+			Name:  c.name,
+			Usage: c.usage,
+			Args:  c.args,
+		}
+		if c.flags != nil {
+			cc.Flags = c.flags()
+		}
+		cmds = append(cmds, cc)
+	}
+	return cmds
+}
+
+func printUsage(name string, commands []command) {
 	fmt.Printf("%s converts between base 10 integers and string representations in arbitraty bases\n", name)
 
 	fmt.Print("\nUsage:\n")
@@ -40,8 +119,9 @@ func printUsage(name string) {
 	fmt.Printf("  %s [command]\n", name)
 
 	fmt.Print("\nAvailable Commands:\n")
-	fmt.Print("  encode\tencodes a base 10 integer in a new base\n")
-	fmt.Print("  decode\tdecodes a string representation of a base 10 integer\n")
+	for _, c := range commands {
+		fmt.Printf("  %s\t%s\n", c.name, c.usage)
+	}
 
 	fmt.Print("\nFlags:\n")
 	fmt.Printf("  -h, -help\thelp for %s\n", name)
@@ -0,0 +1,267 @@
+package completion
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// Command describes a (sub)command for which completions are generated
+type Command struct {
+	Name  string
+	Usage string
+	Flags *flag.FlagSet
+	// Args are the values completed for the command's positional arguments
+	Args []string
+}
+
+// Shells are the shells for which completion scripts can be generated
+var Shells = []string{"bash", "zsh", "fish"}
+
+// Run executes the completion (sub)command, writing a completion script for the program
+// with the specified top level flags and commands
+func Run(args []string, name string, topLevel *flag.FlagSet, commands []Command) error {
+	cmd := flag.NewFlagSet("completion", flag.ExitOnError)
+	setUsage(cmd, name)
+
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+	if cmd.NArg() == 0 {
+		cmd.Usage()
+		return nil
+	}
+	if cmd.NArg() != 1 {
+		return errors.New("must specify shell as single positional argument")
+	}
+
+	switch shell := cmd.Arg(0); shell {
+	case "bash":
+		writeBash(os.Stdout, name, topLevel, commands)
+	case "zsh":
+		writeZsh(os.Stdout, name, topLevel, commands)
+	case "fish":
+		writeFish(os.Stdout, name, topLevel, commands)
+	default:
+		return fmt.Errorf("unsupported shell %s, must be one of [%s]", shell, strings.Join(Shells, ", "))
+	}
+	return nil
+}
+
+// flagValues returns the values completed for a flag, which are the alphabet names for alphabet flags
+func flagValues(f *flag.Flag) []string {
+	if f.Name == "a" || f.Name == "alphabet" {
+		return alphabet.Names()
+	}
+	return nil
+}
+
+// isBoolFlag reports whether a flag does not take a value
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// sortedFlags returns a flag set's flags in lexicographical order
+func sortedFlags(fs *flag.FlagSet) []*flag.Flag {
+	flags := []*flag.Flag{}
+	if fs == nil {
+		return flags
+	}
+	fs.VisitAll(func(f *flag.Flag) {
+		flags = append(flags, f)
+	})
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Name < flags[j].Name
+	})
+	return flags
+}
+
+// flagNames returns the space separated names of a flag set's flags, including the leading dash
+func flagNames(fs *flag.FlagSet) string {
+	names := []string{}
+	for _, f := range sortedFlags(fs) {
+		names = append(names, "-"+f.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func writeBash(w io.Writer, name string, topLevel *flag.FlagSet, commands []Command) {
+	fn := "_" + name
+
+	fmt.Fprintf(w, "# bash completion for %s\n", name)
+	fmt.Fprintf(w, "%s() {\n", fn)
+	fmt.Fprint(w, "    local cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	fmt.Fprint(w, "    local prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+	fmt.Fprint(w, "    COMPREPLY=()\n\n")
+
+	cmdNames := []string{}
+	for _, c := range commands {
+		cmdNames = append(cmdNames, c.Name)
+	}
+	fmt.Fprint(w, "    if [[ ${COMP_CWORD} -eq 1 ]]; then\n")
+	fmt.Fprintf(w, "        COMPREPLY=($(compgen -W \"%s %s\" -- \"${cur}\"))\n", strings.Join(cmdNames, " "), flagNames(topLevel))
+	fmt.Fprint(w, "        return\n")
+	fmt.Fprint(w, "    fi\n\n")
+
+	fmt.Fprint(w, "    case \"${COMP_WORDS[1]}\" in\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "    %s)\n", c.Name)
+		fmt.Fprint(w, "        case \"${prev}\" in\n")
+		patterns, values := valueFlagGroups(c.Flags)
+		for i := range patterns {
+			fmt.Fprintf(w, "        %s)\n", patterns[i])
+			fmt.Fprintf(w, "            COMPREPLY=($(compgen -W \"%s\" -- \"${cur}\"))\n", values[i])
+			fmt.Fprint(w, "            return\n")
+			fmt.Fprint(w, "            ;;\n")
+		}
+		fmt.Fprint(w, "        esac\n")
+		fmt.Fprintf(w, "        COMPREPLY=($(compgen -W \"%s %s\" -- \"${cur}\"))\n", flagNames(c.Flags), strings.Join(c.Args, " "))
+		fmt.Fprint(w, "        ;;\n")
+	}
+	fmt.Fprint(w, "    esac\n")
+	fmt.Fprint(w, "}\n\n")
+	fmt.Fprintf(w, "complete -F %s %s\n", fn, name)
+}
+
+// valueFlagGroups groups the flags that take a value by their completed values, returning
+// a case pattern matching the flags of each group and the group's space separated values
+func valueFlagGroups(fs *flag.FlagSet) ([]string, []string) {
+	patterns := []string{}
+	values := []string{}
+	index := map[string]int{}
+	for _, f := range sortedFlags(fs) {
+		if isBoolFlag(f) {
+			continue
+		}
+		v := strings.Join(flagValues(f), " ")
+		i, ok := index[v]
+		if !ok {
+			index[v] = len(patterns)
+			patterns = append(patterns, "-"+f.Name)
+			values = append(values, v)
+			continue
+		}
+		patterns[i] += "|-" + f.Name
+	}
+	return patterns, values
+}
+
+// zshEscape escapes characters with special meaning in a zsh _arguments specification
+func zshEscape(str string) string {
+	replacer := strings.NewReplacer("'", `'\''`, "[", `\[`, "]", `\]`, ":", `\:`)
+	return replacer.Replace(str)
+}
+
+// zshAction returns the _arguments action completing the specified values, which is
+// a space to complete nothing if there are no values
+func zshAction(values []string) string {
+	if len(values) == 0 {
+		return " "
+	}
+	return "(" + strings.Join(values, " ") + ")"
+}
+
+func writeZsh(w io.Writer, name string, topLevel *flag.FlagSet, commands []Command) {
+	fn := "_" + name
+
+	fmt.Fprintf(w, "#compdef %s\n\n", name)
+	fmt.Fprintf(w, "%s() {\n", fn)
+	fmt.Fprint(w, "    local -a commands\n")
+	fmt.Fprint(w, "    commands=(\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "        '%s:%s'\n", c.Name, zshEscape(c.Usage))
+	}
+	fmt.Fprint(w, "    )\n\n")
+
+	fmt.Fprint(w, "    if (( CURRENT == 2 )); then\n")
+	fmt.Fprint(w, "        _describe 'command' commands\n")
+	fmt.Fprint(w, "        _arguments")
+	for _, f := range sortedFlags(topLevel) {
+		fmt.Fprintf(w, " '-%s[%s]'", f.Name, zshEscape(f.Usage))
+	}
+	fmt.Fprint(w, "\n")
+	fmt.Fprint(w, "        return\n")
+	fmt.Fprint(w, "    fi\n\n")
+
+	fmt.Fprint(w, "    case $words[2] in\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "    %s)\n", c.Name)
+		fmt.Fprint(w, "        _arguments \\\n")
+		for _, f := range sortedFlags(c.Flags) {
+			spec := fmt.Sprintf("-%s[%s]", f.Name, zshEscape(f.Usage))
+			if !isBoolFlag(f) {
+				spec += fmt.Sprintf(":%s:%s", f.Name, zshAction(flagValues(f)))
+			}
+			fmt.Fprintf(w, "            '%s' \\\n", spec)
+		}
+		fmt.Fprintf(w, "            '*:argument:%s'\n", zshAction(c.Args))
+		fmt.Fprint(w, "        ;;\n")
+	}
+	fmt.Fprint(w, "    esac\n")
+	fmt.Fprint(w, "}\n\n")
+	fmt.Fprintf(w, "compdef %s %s\n", fn, name)
+}
+
+// fishEscape escapes characters with special meaning in a single quoted fish string
+func fishEscape(str string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "'", `\'`)
+	return replacer.Replace(str)
+}
+
+func writeFish(w io.Writer, name string, topLevel *flag.FlagSet, commands []Command) {
+	cmdNames := []string{}
+	for _, c := range commands {
+		cmdNames = append(cmdNames, c.Name)
+	}
+	noCommand := fmt.Sprintf("not __fish_seen_subcommand_from %s", strings.Join(cmdNames, " "))
+
+	fmt.Fprintf(w, "# fish completion for %s\n", name)
+	fmt.Fprintf(w, "complete -c %s -f\n", name)
+	for _, f := range sortedFlags(topLevel) {
+		fmt.Fprintf(w, "complete -c %s -n '%s' -o %s -d '%s'\n", name, noCommand, f.Name, fishEscape(f.Usage))
+	}
+	for _, c := range commands {
+		fmt.Fprintf(w, "complete -c %s -n '%s' -a %s -d '%s'\n", name, noCommand, c.Name, fishEscape(c.Usage))
+	}
+
+	for _, c := range commands {
+		seen := fmt.Sprintf("__fish_seen_subcommand_from %s", c.Name)
+		for _, f := range sortedFlags(c.Flags) {
+			line := fmt.Sprintf("complete -c %s -n '%s' -o %s -d '%s'", name, seen, f.Name, fishEscape(f.Usage))
+			if !isBoolFlag(f) {
+				line += " -x"
+				if values := flagValues(f); len(values) > 0 {
+					line += fmt.Sprintf(" -a '%s'", strings.Join(values, " "))
+				}
+			}
+			fmt.Fprintln(w, line)
+		}
+		if len(c.Args) > 0 {
+			fmt.Fprintf(w, "complete -c %s -n '%s' -a '%s'\n", name, seen, strings.Join(c.Args, " "))
+		}
+	}
+}
+
+func setUsage(cmd *flag.FlagSet, name string) {
+	cmd.Usage = func() {
+		fmt.Printf("%s generates a shell completion script\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s SHELL\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  SHELL\tshell for which to generate the script, one of [%s] (required)\n\n", strings.Join(Shells, ", "))
+
+		fmt.Print("Examples:\n")
+		fmt.Printf("  source <(%s %s bash)\n", name, cmd.Name())
+		fmt.Printf("  %s %s fish > ~/.config/fish/completions/%s.fish\n", name, cmd.Name(), name)
+	}
+}
@@ -0,0 +1,281 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+// Keys are the names of the settings that can be configured, each of which provides the
+// default value of the command flag with the same name
+var Keys = []string{"base", "digits", "pad", "alphabet", "prefix"}
+
+// shortFlags maps setting keys to the short names of the flags sharing their values
+var shortFlags = map[string]string{
+	"base":     "b",
+	"digits":   "d",
+	"pad":      "p",
+	"alphabet": "a",
+}
+
+const (
+	// envPrefix is the prefix of the environment variables that configure settings
+	envPrefix = "BASECONV_"
+	// envPath is the environment variable that overrides the path of the config file
+	envPath = envPrefix + "CONFIG"
+)
+
+// Setting is a configured value and a description of its source
+type Setting struct {
+	Value  string
+	Source string
+}
+
+// Path returns the path of the config file
+func Path() (string, error) {
+	if path, ok := os.LookupEnv(envPath); ok {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "baseconv", "config"), nil
+}
+
+// Load returns the settings configured by environment variables and the config file for the
+// specified profile, with environment variables taking precedence over the config file
+func Load(profile string) (map[string]Setting, error) {
+	settings := map[string]Setting{}
+
+	// an unresolvable home directory means there is no config file unless a profile needs one
+	path, err := Path()
+	if err != nil {
+		if profile != "" {
+			return nil, fmt.Errorf("profile [%s] not found, cannot locate config file: %v", profile, err)
+		}
+	} else {
+		settings, err = loadFile(path, profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range Keys {
+		env := envPrefix + strings.ToUpper(key)
+		if val, ok := os.LookupEnv(env); ok {
+			settings[key] = Setting{Value: val, Source: "env " + env}
+		}
+	}
+
+	return settings, nil
+}
+
+// loadFile returns the settings of the config file at path for the specified profile, treating a
+// missing file as empty unless a profile is specified
+func loadFile(path string, profile string) (map[string]Setting, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if profile != "" {
+			return nil, fmt.Errorf("profile [%s] not found, config file %s does not exist", profile, path)
+		}
+		return map[string]Setting{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseFile(f, path, profile)
+}
+
+// Apply sets each flag that was not set on the command line to its configured value
+func Apply(cmd *flag.FlagSet, profile string) error {
+	settings, err := Load(profile)
+	if err != nil {
+		return err
+	}
+
+	set := map[string]bool{}
+	cmd.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	for _, key := range Keys {
+		setting, ok := settings[key]
+		if !ok || cmd.Lookup(key) == nil || set[key] || set[shortFlags[key]] {
+			continue
+		}
+		if err := cmd.Set(key, setting.Value); err != nil {
+			return fmt.Errorf("invalid value [%s] for %s from %s: %v", setting.Value, key, setting.Source, err)
+		}
+	}
+	return nil
+}
+
+// parseFile parses a config file of key = value lines, in which the lines before the first
+// [profile] header apply to all profiles and the lines following a header apply to that profile
+func parseFile(r io.Reader, path string, profile string) (map[string]Setting, error) {
+	settings := map[string]Setting{}
+	section := ""
+	found := profile == ""
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile {
+				found = true
+			}
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected a line of the form key = value", path, lineNum)
+		}
+		key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if !isKey(key) {
+			return nil, fmt.Errorf("%s:%d: unknown setting [%s]", path, lineNum, key)
+		}
+
+		switch section {
+		case "":
+			settings[key] = Setting{Value: val, Source: "file " + path}
+		case profile:
+			settings[key] = Setting{Value: val, Source: fmt.Sprintf("file %s [%s]", path, profile)}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("profile [%s] not found in config file %s", profile, path)
+	}
+	return settings, nil
+}
+
+func isKey(key string) bool {
+	for _, k := range Keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Run executes the config (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("config", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the config (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("config", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	settings, err := Load(opts.profile)
+	if err != nil {
+		return err
+	}
+
+	path, err := Path()
+	if err != nil {
+		path = fmt.Sprintf("none (%v)", err)
+	}
+	fmt.Printf("config file: %s\n\n", path)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SETTING\tVALUE\tSOURCE")
+	for _, key := range Keys {
+		setting, ok := settings[key]
+		if !ok {
+			setting = Setting{Source: "flag default"}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", key, setting.Value, setting.Source)
+	}
+	return w.Flush()
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	profile string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	AttachProfile(cmd, &opts.profile)
+}
+
+// AttachProfile adds the flag selecting the configuration profile to a command
+func AttachProfile(cmd *flag.FlagSet, profile *string) {
+	cmd.StringVar(profile, "profile", "", "name of config file profile providing default flag values")
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	if args[0] != "show" {
+		return fmt.Errorf("unknown config action %s", args[0])
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	if cmd.NArg() != 0 {
+		return errors.New("config show does not accept positional arguments")
+	}
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s displays the default flag values configured by environment variables and the config file\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s show [flags]\n\n", cmd.Name())
+
+		fmt.Print("Settings:\n")
+		fmt.Printf("  %s\n\n", strings.Join(Keys, ", "))
+
+		fmt.Print("Precedence:\n")
+		fmt.Print("  command line flags, then environment variables named BASECONV_<SETTING>, then the config file\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfig = `
+# settings for all profiles
+base = 62
+digits = 7
+
+[shorturl]
+pad = true
+prefix = s_
+
+[crockford]
+base = 32
+alphabet = crockford
+`
+
+func TestParseFile(t *testing.T) {
+	type testCase struct {
+		profile  string
+		expected map[string]string
+	}
+
+	tests := map[string]testCase{
+		"no profile": {
+			profile: "",
+			expected: map[string]string{
+				"base":   "62",
+				"digits": "7",
+			},
+		},
+		"profile adding settings": {
+			profile: "shorturl",
+			expected: map[string]string{
+				"base":   "62",
+				"digits": "7",
+				"pad":    "true",
+				"prefix": "s_",
+			},
+		},
+		"profile overriding settings": {
+			profile: "crockford",
+			expected: map[string]string{
+				"base":     "32",
+				"digits":   "7",
+				"alphabet": "crockford",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := parseFile(strings.NewReader(testConfig), "config", test.profile)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(res) != len(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+				return
+			}
+			for key, val := range test.expected {
+				if res[key].Value != val {
+					t.Errorf("result %v not equal to expected %v", res, test.expected)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestParseFileWithError(t *testing.T) {
+	type testCase struct {
+		config  string
+		profile string
+	}
+
+	tests := map[string]testCase{
+		"unknown profile": {
+			config:  testConfig,
+			profile: "unknown",
+		},
+		"unknown setting": {
+			config: "size = 10",
+		},
+		"line without separator": {
+			config: "base 10",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := parseFile(strings.NewReader(test.config), "config", test.profile)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(envPath, path)
+	t.Setenv("BASECONV_DIGITS", "9")
+
+	var base, digits uint64
+	var pad bool
+	var prefix string
+	cmd := flag.NewFlagSet("test", flag.ContinueOnError)
+	cmd.Uint64Var(&base, "b", 0, "")
+	cmd.Uint64Var(&base, "base", 0, "")
+	cmd.Uint64Var(&digits, "digits", 0, "")
+	cmd.BoolVar(&pad, "pad", false, "")
+	cmd.StringVar(&prefix, "prefix", "", "")
+
+	if err := cmd.Parse([]string{"-b", "16"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(cmd, "shorturl"); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	if base != 16 {
+		t.Errorf("base %d from flag not equal to expected %d", base, 16)
+	}
+	if digits != 9 {
+		t.Errorf("digits %d from env not equal to expected %d", digits, 9)
+	}
+	if !pad {
+		t.Errorf("pad %t from file not equal to expected %t", pad, true)
+	}
+	if prefix != "s_" {
+		t.Errorf("prefix %s from file not equal to expected %s", prefix, "s_")
+	}
+}
+
+func TestApplyWithoutHome(t *testing.T) {
+	unsetenv(t, "HOME")
+	unsetenv(t, envPath)
+	t.Setenv("BASECONV_DIGITS", "9")
+
+	var digits uint64
+	cmd := flag.NewFlagSet("test", flag.ContinueOnError)
+	cmd.Uint64Var(&digits, "digits", 0, "")
+
+	if err := Apply(cmd, ""); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if digits != 9 {
+		t.Errorf("digits %d from env not equal to expected %d", digits, 9)
+	}
+
+	if err := Apply(cmd, "shorturl"); err == nil {
+		t.Fatal("expected non nil error")
+	}
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(envPath, path)
+	if err := Apply(cmd, "shorturl"); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+}
+
+// unsetenv unsets an environment variable for the duration of a test
+func unsetenv(t *testing.T, key string) {
+	t.Setenv(key, "")
+	if err := os.Unsetenv(key); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	type testCase struct {
+		args     []string
+		expected error
+	}
+
+	tests := map[string]testCase{
+		"no action": {
+			args:     []string{},
+			expected: errNoArgs,
+		},
+		"short help flag": {
+			args:     []string{"-h"},
+			expected: errNoArgs,
+		},
+		"long help flag": {
+			args:     []string{"-help"},
+			expected: errNoArgs,
+		},
+		"show action": {
+			args:     []string{"show"},
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envPath, filepath.Join(t.TempDir(), "config"))
+			cmd := flag.NewFlagSet("config", flag.ContinueOnError)
+			opts := &cmdOpts{}
+			attachOpts(cmd, opts)
+			err := parseArgs(cmd, opts, test.args)
+			if err != test.expected {
+				t.Errorf("error %v not equal to expected %v", err, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseArgsWithError(t *testing.T) {
+	cmd := flag.NewFlagSet("config", flag.ContinueOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	err := parseArgs(cmd, opts, []string{"list"})
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
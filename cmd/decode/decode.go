@@ -4,9 +4,15 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"os"
 
+	"github.com/dkaslovsky/baseconv/cmd/config"
 	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/base85"
 	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+	"github.com/dkaslovsky/baseconv/pkg/format"
+	"github.com/dkaslovsky/baseconv/pkg/obfuscate"
 )
 
 // Run executes the decode (sub)command
@@ -28,27 +34,98 @@ func Run(args []string) error {
 	return run(opts)
 }
 
+// Flags returns the flags of the decode (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("decode", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
 func run(opts *cmdOpts) error {
-	numeric, err := alphabet.FromString(opts.enc)
+	if opts.stream != nil || opts.b85 != nil {
+		return decodeFile(opts)
+	}
+
+	enc, err := opts.format.Strip(opts.enc)
+	if err != nil {
+		return err
+	}
+
+	numeric, err := opts.alpha.FromString(enc)
 	if err != nil {
 		return err
 	}
 
-	dec, derr := baseconv.ToBase10(numeric, opts.base)
+	if opts.maxDigits > 0 && uint64(len(numeric)) > opts.maxDigits {
+		return fmt.Errorf("cannot decode %s with more than %d digits", enc, opts.maxDigits)
+	}
+
+	dec, derr := baseconv.ToBase10Any(numeric, opts.base)
 	if derr != nil {
 		return derr
 	}
 
+	if opts.perm != nil {
+		if !dec.IsUint64() || dec.Uint64() > opts.perm.Max() {
+			return fmt.Errorf("cannot deobfuscate %s exceeding the maximum [%d] of %d digits", dec, opts.perm.Max(), opts.maxDigits)
+		}
+		orig, err := opts.perm.Invert(dec.Uint64())
+		if err != nil {
+			return err
+		}
+		dec.SetUint64(orig)
+	}
+
 	fmt.Println(dec)
 	return nil
 }
 
+// decodeFile writes the bytes decoded from the stream encoding in a file, or in stdin for "-", to stdout
+func decodeFile(opts *cmdOpts) error {
+	in := os.Stdin
+	if opts.file != "-" {
+		f, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var r io.Reader
+	if opts.b85 != nil {
+		r = base85.NewDecoder(opts.b85, in)
+	} else {
+		r = baseconv.NewDecoder(opts.stream, in)
+	}
+	_, err := io.Copy(os.Stdout, r)
+	return err
+}
+
 // errorNoArgs is returned when no arguments are passed to the command
 var errNoArgs = errors.New("missing required argument(s)")
 
+// fileConflicts are the flags that do not apply to -file, which are rejected rather than ignored
+var fileConflicts = []string{"d", "digits", "prefix", "separator", "key"}
+
 type cmdOpts struct {
 	// command flags
-	base uint64
+	base      uint64
+	maxDigits uint64
+	alphaName string
+	prefix    string
+	separator string
+	key       string
+	file      string
+	b85Name   string
+	profile   string
+
+	// derived from flags
+	alpha  *alphabet.Alphabet
+	format format.Format
+	perm   *obfuscate.Permutation
+	stream *baseconv.StreamEncoding
+	b85    *base85.Encoding
 
 	// positional args
 	enc string
@@ -57,6 +134,21 @@ type cmdOpts struct {
 func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
 	cmd.Uint64Var(&opts.base, "b", 0, "base of input number")
 	cmd.Uint64Var(&opts.base, "base", 0, "base of input number")
+
+	cmd.Uint64Var(&opts.maxDigits, "d", 0, "maximum number of digits of input number (0 for no maximum)")
+	cmd.Uint64Var(&opts.maxDigits, "digits", 0, "maximum number of digits of input number (0 for no maximum)")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used for decoding")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used for decoding")
+
+	cmd.StringVar(&opts.prefix, "prefix", "", "prefix removed from the input")
+	cmd.StringVar(&opts.separator, "separator", "-", "separator removed from between groups of input characters")
+
+	cmd.StringVar(&opts.file, "file", "", "file, or - for stdin, of block encoded bytes to decode instead of an integer, used with only the base, alphabet and base85 flags")
+	cmd.StringVar(&opts.b85Name, "base85", "", "base 85 encoding, one of ascii85, rfc1924 or z85, used for decoding the -file in groups of 4 bytes")
+	cmd.StringVar(&opts.key, "key", "", "secret key used to obfuscate the encoded integer among the integers encoded by the number of digits")
+
+	config.AttachProfile(cmd, &opts.profile)
 }
 
 func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
@@ -67,8 +159,31 @@ func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
 	if err != nil {
 		return err
 	}
+	// flags set on the command line, before defaults are applied from the config
+	set := map[string]bool{}
+	cmd.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
 
 	// handle positional argument(s)
+	if opts.b85Name != "" && opts.file == "" {
+		return errors.New("must specify -file to use -base85")
+	}
+	if opts.file != "" {
+		if cmd.NArg() != 0 {
+			return errors.New("cannot specify positional argument with -file")
+		}
+		for _, name := range fileConflicts {
+			if set[name] {
+				return fmt.Errorf("cannot specify -%s with -file", name)
+			}
+		}
+		return validateFileOpts(opts)
+	}
 	if cmd.NArg() != 1 {
 		return errors.New("must specify encoded string as single positional argument")
 	}
@@ -78,19 +193,66 @@ func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
 }
 
 func validateOpts(opts *cmdOpts) error {
-	maxBase := alphabet.Len()
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	maxBase := alpha.Len()
 	if opts.base > maxBase {
 		return fmt.Errorf("base [%d] exceeds alphabet size [%d]", opts.base, maxBase)
 	}
+	opts.format = format.Format{
+		Prefix:    opts.prefix,
+		Separator: opts.separator,
+	}
+	if err := opts.format.Validate(alpha); err != nil {
+		return err
+	}
+
+	if opts.key != "" {
+		if opts.maxDigits == 0 {
+			return errors.New("must specify number of digits to deobfuscate input")
+		}
+		maxNum, err := baseconv.GetLargestBase10(opts.base, opts.maxDigits)
+		if err != nil {
+			return err
+		}
+		opts.perm, err = obfuscate.New([]byte(opts.key), maxNum)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func validateFileOpts(opts *cmdOpts) error {
+	if opts.b85Name != "" {
+		enc, err := base85.Get(opts.b85Name)
+		if err != nil {
+			return err
+		}
+		opts.b85 = enc
+		return nil
+	}
+
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+	opts.stream, err = baseconv.NewStreamEncoding(opts.base, alpha)
+	return err
+}
+
 func setUsage(cmd *flag.FlagSet) {
 	cmd.Usage = func() {
 		fmt.Printf("%s decodes a string representation of a base 10 integer from an arbitrary base\n\n", cmd.Name())
 
 		fmt.Print("Usage:\n")
-		fmt.Printf("  %s [flags] STRINGREP\n\n", cmd.Name())
+		fmt.Printf("  %s [flags] STRINGREP\n", cmd.Name())
+		fmt.Printf("  %s [flags] -file PATH\n\n", cmd.Name())
 
 		fmt.Print("Args:\n")
 		fmt.Printf("  STRINGREP\tstring representation of an encoded base 10 integer to decode (required)\n\n")
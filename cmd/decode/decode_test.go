@@ -0,0 +1,74 @@
+package decode
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+)
+
+func parse(t *testing.T, args ...string) (*cmdOpts, error) {
+	t.Setenv("BASECONV_CONFIG", filepath.Join(t.TempDir(), "config"))
+	cmd := flag.NewFlagSet("decode", flag.ContinueOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	return opts, parseArgs(cmd, opts, args)
+}
+
+func TestParseArgsFile(t *testing.T) {
+	type testCase struct {
+		args []string
+	}
+
+	tests := map[string]testCase{
+		"file": {
+			args: []string{"-b", "62", "-file", "-"},
+		},
+		"file with base85": {
+			args: []string{"-base85", "ascii85", "-file", "-"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := parse(t, test.args...)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseArgsFileWithError(t *testing.T) {
+	type testCase struct {
+		args []string
+	}
+
+	tests := map[string]testCase{
+		"file with positional argument": {
+			args: []string{"-b", "62", "-file", "-", "g8"},
+		},
+		"file with digits": {
+			args: []string{"-b", "62", "-d", "7", "-file", "-"},
+		},
+		"file with key": {
+			args: []string{"-b", "62", "-key", "s3cret", "-file", "-"},
+		},
+		"file with prefix": {
+			args: []string{"-b", "62", "-prefix", "id_", "-file", "-"},
+		},
+		"file with separator": {
+			args: []string{"-b", "62", "-separator", ".", "-file", "-"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := parse(t, test.args...)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -4,10 +4,18 @@ import (
 	"errors"
 	"flag"
 	"fmt"
-	"strconv"
+	"io"
+	"math/big"
+	"os"
+	"strings"
 
+	"github.com/dkaslovsky/baseconv/cmd/config"
 	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/base85"
 	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+	"github.com/dkaslovsky/baseconv/pkg/blocklist"
+	"github.com/dkaslovsky/baseconv/pkg/format"
+	"github.com/dkaslovsky/baseconv/pkg/obfuscate"
 )
 
 // Run executes the encode (sub)command
@@ -29,50 +37,143 @@ func Run(args []string) error {
 	return run(opts)
 }
 
+// Flags returns the flags of the encode (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("encode", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
 func run(opts *cmdOpts) error {
-	enc, err := baseconv.FromBase10(opts.num, opts.base)
+	if opts.stream != nil || opts.b85 != nil {
+		return encodeFile(opts)
+	}
+
+	if opts.perm != nil {
+		obf, err := opts.perm.Apply(opts.num.Uint64())
+		if err != nil {
+			return err
+		}
+		opts.num.SetUint64(obf)
+	}
+
+	enc, err := baseconv.FromBase10Any(opts.num, opts.base)
 	if err != nil {
 		return err
 	}
 
-	str, serr := alphabet.ToString(enc)
+	str, serr := opts.alpha.ToString(enc)
 	if serr != nil {
 		return serr
 	}
 
-	if opts.pad {
-		str, err = alphabet.Pad(str, int(opts.maxDigits))
+	// obfuscated values are always padded so that their length does not reveal their magnitude
+	if opts.pad || opts.perm != nil {
+		str, err = opts.alpha.Pad(str, int(opts.maxDigits))
 		if err != nil {
 			return err
 		}
 	}
 
-	fmt.Println(str)
+	if opts.blocklist != nil {
+		if word, blocked := opts.blocklist.Match(str); blocked {
+			return fmt.Errorf("encoding %s of %s contains blocked word [%s]", str, opts.num, word)
+		}
+	}
+
+	fmt.Println(opts.format.Apply(str))
+	return nil
+}
+
+// encodeFile writes the stream encoding of the contents of a file, or of stdin for "-", to stdout
+func encodeFile(opts *cmdOpts) error {
+	in := os.Stdin
+	if opts.file != "-" {
+		f, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var w io.WriteCloser
+	if opts.b85 != nil {
+		w = base85.NewEncoder(opts.b85, os.Stdout)
+	} else {
+		w = baseconv.NewEncoder(opts.stream, os.Stdout)
+	}
+	if _, err := io.Copy(w, in); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	fmt.Println()
 	return nil
 }
 
 // errorNoArgs is returned when no arguments are passed to the command
 var errNoArgs = errors.New("missing required argument(s)")
 
+// fileConflicts are the flags that do not apply to -file, which are rejected rather than ignored
+var fileConflicts = []string{"d", "digits", "p", "pad", "i", "input-base", "prefix", "separator", "group", "key", "blocklist"}
+
 type cmdOpts struct {
 	// command flags
 	base      uint64
 	maxDigits uint64
 	pad       bool
+	alphaName string
+	inputBase uint64
+	prefix    string
+	separator string
+	groupSize uint64
+	key       string
+	blockPath string
+	file      string
+	b85Name   string
+	profile   string
+
+	// derived from flags
+	hasDigits bool
+	alpha     *alphabet.Alphabet
+	format    format.Format
+	perm      *obfuscate.Permutation
+	blocklist *blocklist.Blocklist
+	stream    *baseconv.StreamEncoding
+	b85       *base85.Encoding
 
 	// positional args
-	num uint64
+	num *big.Int
 }
 
 func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
 	cmd.Uint64Var(&opts.base, "b", 0, "new base to encode input integer")
 	cmd.Uint64Var(&opts.base, "base", 0, "new base to encode input integer")
 
-	cmd.Uint64Var(&opts.maxDigits, "d", 0, "maximum number of digits to use for encoding")
-	cmd.Uint64Var(&opts.maxDigits, "digits", 0, "maximum number of digits to use for encoding")
+	cmd.Uint64Var(&opts.maxDigits, "d", 0, "maximum number of digits to use for encoding (no maximum if not set)")
+	cmd.Uint64Var(&opts.maxDigits, "digits", 0, "maximum number of digits to use for encoding (no maximum if not set)")
 
 	cmd.BoolVar(&opts.pad, "p", false, "pad output to have exactly the number of specified digits")
 	cmd.BoolVar(&opts.pad, "pad", false, "pad output to have exactly the number of specified digits")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used for encoding")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used for encoding")
+
+	cmd.Uint64Var(&opts.inputBase, "i", 0, "base of input integer (default inferred from prefix 0b, 0o, 0x or base 10)")
+	cmd.Uint64Var(&opts.inputBase, "input-base", 0, "base of input integer (default inferred from prefix 0b, 0o, 0x or base 10)")
+
+	cmd.StringVar(&opts.prefix, "prefix", "", "prefix prepended to the output")
+	cmd.StringVar(&opts.separator, "separator", "-", "separator inserted between groups of output characters")
+	cmd.Uint64Var(&opts.groupSize, "group", 0, "number of output characters in each group separated by the separator")
+
+	cmd.StringVar(&opts.key, "key", "", "secret key obfuscating the input integer among the integers encoded by the number of digits, padding the output")
+	cmd.StringVar(&opts.file, "file", "", "file, or - for stdin, whose bytes are encoded in blocks instead of an integer, used with only the base, alphabet and base85 flags")
+	cmd.StringVar(&opts.b85Name, "base85", "", "base 85 encoding, one of ascii85, rfc1924 or z85, used for encoding the -file in groups of 4 bytes")
+	cmd.StringVar(&opts.blockPath, "blocklist", "", "file of words, one per line, that cannot appear in the output, or \"default\" for the built-in English list")
+
+	config.AttachProfile(cmd, &opts.profile)
 }
 
 func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
@@ -83,14 +184,43 @@ func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
 	if err != nil {
 		return err
 	}
+	// flags set on the command line, before defaults are applied from the config
+	set := map[string]bool{}
+	cmd.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+	// the number of digits is not limited unless set on the command line or by the config
+	cmd.Visit(func(f *flag.Flag) {
+		if f.Name == "d" || f.Name == "digits" {
+			opts.hasDigits = true
+		}
+	})
 
 	// handle positional argument(s)
+	if opts.b85Name != "" && opts.file == "" {
+		return errors.New("must specify -file to use -base85")
+	}
+	if opts.file != "" {
+		if cmd.NArg() != 0 {
+			return errors.New("cannot specify positional argument with -file")
+		}
+		for _, name := range fileConflicts {
+			if set[name] {
+				return fmt.Errorf("cannot specify -%s with -file", name)
+			}
+		}
+		return validateFileOpts(opts)
+	}
 	if cmd.NArg() != 1 {
 		return errors.New("must specify base 10 integer to encode as single positional argument")
 	}
-	num, nerr := strconv.ParseUint(cmd.Arg(0), 10, 64)
+	num, nerr := parseNum(cmd.Arg(0), opts.inputBase)
 	if nerr != nil {
-		return fmt.Errorf("could not parse positional argument %s as uint64", cmd.Arg(0))
+		return nerr
 	}
 	opts.num = num
 
@@ -98,29 +228,117 @@ func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
 }
 
 func validateOpts(opts *cmdOpts) error {
-	maxBase := alphabet.Len()
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	maxBase := alpha.Len()
 	if opts.base > maxBase {
 		return fmt.Errorf("base [%d] exceeds alphabet size [%d]", opts.base, maxBase)
 	}
-	maxNum, err := baseconv.GetLargestBase10(opts.base, opts.maxDigits)
+	opts.format = format.Format{
+		Prefix:    opts.prefix,
+		Separator: opts.separator,
+		GroupSize: int(opts.groupSize),
+	}
+	if err := opts.format.Validate(alpha); err != nil {
+		return err
+	}
+
+	switch opts.blockPath {
+	case "":
+	case "default":
+		opts.blocklist = blocklist.Default()
+	default:
+		opts.blocklist, err = blocklist.Load(opts.blockPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !opts.hasDigits {
+		if opts.pad {
+			return errors.New("must specify number of digits to pad output")
+		}
+		if opts.key != "" {
+			return errors.New("must specify number of digits to obfuscate input")
+		}
+		return nil
+	}
+	enc, err := baseconv.FromBase10Any(opts.num, opts.base)
 	if err != nil {
 		return err
 	}
-	if opts.num > maxNum {
-		return fmt.Errorf("cannot encode %d in base %d with %d digits", opts.num, opts.base, opts.maxDigits)
+	// zero digits encode only the integer 0
+	if uint64(len(enc)) > opts.maxDigits && opts.num.Sign() != 0 {
+		return fmt.Errorf("cannot encode %s in base %d with %d digits", opts.num, opts.base, opts.maxDigits)
+	}
+	if opts.key != "" {
+		if !opts.num.IsUint64() {
+			return fmt.Errorf("cannot obfuscate %s exceeding the maximum uint64", opts.num)
+		}
+		maxNum, err := baseconv.GetLargestBase10(opts.base, opts.maxDigits)
+		if err != nil {
+			return err
+		}
+		opts.perm, err = obfuscate.New([]byte(opts.key), maxNum)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func validateFileOpts(opts *cmdOpts) error {
+	if opts.b85Name != "" {
+		enc, err := base85.Get(opts.b85Name)
+		if err != nil {
+			return err
+		}
+		opts.b85 = enc
+		return nil
+	}
+
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+	opts.stream, err = baseconv.NewStreamEncoding(opts.base, alpha)
+	return err
+}
+
+// parseNum parses a non-negative integer of arbitrary size in the specified base; a base of 0
+// infers the base from a Go-style prefix (0b, 0o, 0 or 0x) and accepts underscore digit separators
+func parseNum(str string, base uint64) (*big.Int, error) {
+	if base == 1 || base > 62 {
+		return nil, fmt.Errorf("input base [%d] must be between 2 and 62", base)
+	}
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		return nil, fmt.Errorf("could not parse positional argument %s as a non-negative integer", str)
+	}
+	if base != 0 {
+		str = strings.ReplaceAll(str, "_", "")
+	}
+	num, ok := new(big.Int).SetString(str, int(base))
+	if !ok {
+		return nil, fmt.Errorf("could not parse positional argument %s as a non-negative integer", str)
+	}
+	return num, nil
+}
+
 func setUsage(cmd *flag.FlagSet) {
 	cmd.Usage = func() {
 		fmt.Printf("%s encodes a base 10 integer in a new base\n\n", cmd.Name())
 
 		fmt.Print("Usage:\n")
-		fmt.Printf("  %s [flags] NUM\n\n", cmd.Name())
+		fmt.Printf("  %s [flags] NUM\n", cmd.Name())
+		fmt.Printf("  %s [flags] -file PATH\n\n", cmd.Name())
 
 		fmt.Print("Args:\n")
-		fmt.Printf("  NUM\tpositive base 10 integer to encode (required)\n\n")
+		fmt.Printf("  NUM\tpositive integer to encode, optionally with a 0b, 0o or 0x prefix and _ separators (required)\n\n")
 
 		fmt.Printf("Flags:\n")
 		cmd.PrintDefaults()
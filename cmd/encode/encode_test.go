@@ -0,0 +1,136 @@
+package encode
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+)
+
+func parse(t *testing.T, args ...string) (*cmdOpts, error) {
+	t.Setenv("BASECONV_CONFIG", filepath.Join(t.TempDir(), "config"))
+	cmd := flag.NewFlagSet("encode", flag.ContinueOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	return opts, parseArgs(cmd, opts, args)
+}
+
+func TestParseArgsDigits(t *testing.T) {
+	type testCase struct {
+		args     []string
+		expected uint64
+	}
+
+	tests := map[string]testCase{
+		"no digits flag": {
+			args:     []string{"-b", "62", "1000000000001"},
+			expected: 0,
+		},
+		"no digits flag with integer exceeding uint64": {
+			args:     []string{"-b", "62", "100000000000000000000000"},
+			expected: 0,
+		},
+		"zero digits with zero": {
+			args:     []string{"-b", "62", "-d", "0", "0"},
+			expected: 0,
+		},
+		"nonzero digits": {
+			args:     []string{"-b", "62", "-d", "7", "1000000000001"},
+			expected: 7,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			opts, err := parse(t, test.args...)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if opts.maxDigits != test.expected {
+				t.Errorf("digits %d not equal to expected %d", opts.maxDigits, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseArgsDigitsWithError(t *testing.T) {
+	type testCase struct {
+		args []string
+	}
+
+	tests := map[string]testCase{
+		"integer exceeding digits": {
+			args: []string{"-b", "62", "-d", "6", "1000000000001"},
+		},
+		"integer exceeding uint64 and digits": {
+			args: []string{"-b", "62", "-d", "7", "100000000000000000000000"},
+		},
+		"integer exceeding zero digits": {
+			args: []string{"-b", "62", "-d", "0", "1"},
+		},
+		"pad without digits": {
+			args: []string{"-b", "62", "-p", "1"},
+		},
+		"key without digits": {
+			args: []string{"-b", "62", "-key", "s3cret", "1"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := parse(t, test.args...)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestParseArgsFileWithError(t *testing.T) {
+	type testCase struct {
+		args []string
+	}
+
+	tests := map[string]testCase{
+		"file with positional argument": {
+			args: []string{"-b", "62", "-file", "-", "1"},
+		},
+		"file with digits": {
+			args: []string{"-b", "62", "-d", "7", "-file", "-"},
+		},
+		"file with key": {
+			args: []string{"-b", "62", "-key", "s3cret", "-file", "-"},
+		},
+		"file with prefix": {
+			args: []string{"-b", "62", "-prefix", "id_", "-file", "-"},
+		},
+		"file with separator": {
+			args: []string{"-b", "62", "-separator", ".", "-file", "-"},
+		},
+		"file with blocklist": {
+			args: []string{"-b", "62", "-blocklist", "default", "-file", "-"},
+		},
+		"base85 without file": {
+			args: []string{"-base85", "z85", "1"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := parse(t, test.args...)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestParseArgsFileWithConfiguredDigits(t *testing.T) {
+	t.Setenv("BASECONV_DIGITS", "7")
+	_, err := parse(t, "-b", "62", "-file", "-")
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package hashids
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/hashids"
+)
+
+// Actions are the actions of the hashids (sub)command
+var Actions = []string{"encode", "decode"}
+
+// Run executes the hashids (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("hashids", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the hashids (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("hashids", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	h, err := hashids.New(opts.salt, int(opts.minLength), opts.alpha)
+	if err != nil {
+		return err
+	}
+
+	if opts.action == "encode" {
+		enc, err := h.Encode(opts.nums)
+		if err != nil {
+			return err
+		}
+		fmt.Println(enc)
+		return nil
+	}
+
+	nums, err := h.Decode(opts.hash)
+	if err != nil {
+		return err
+	}
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.FormatUint(n, 10)
+	}
+	fmt.Println(strings.Join(strs, " "))
+	return nil
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	salt      string
+	minLength uint64
+	alphaName string
+	profile   string
+
+	// derived from flags
+	alpha *alphabet.Alphabet
+
+	// positional args
+	action string
+	nums   []uint64
+	hash   string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.StringVar(&opts.salt, "salt", "", "salt shuffling the alphabet")
+	cmd.Uint64Var(&opts.minLength, "min-length", 0, "minimum length of encoded strings")
+
+	cmd.StringVar(&opts.alphaName, "a", "", "name of alphabet used for encoding (default Hashids alphabet)")
+	cmd.StringVar(&opts.alphaName, "alphabet", "", "name of alphabet used for encoding (default Hashids alphabet)")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	opts.action = args[0]
+	if opts.action != "encode" && opts.action != "decode" {
+		return fmt.Errorf("unknown hashids action %s", opts.action)
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	if opts.action == "encode" {
+		if cmd.NArg() == 0 {
+			return errors.New("must specify one or more base 10 integers to encode as positional arguments")
+		}
+		for _, arg := range cmd.Args() {
+			num, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return fmt.Errorf("could not parse positional argument %s as a non-negative integer", arg)
+			}
+			opts.nums = append(opts.nums, num)
+		}
+	} else {
+		if cmd.NArg() != 1 {
+			return errors.New("must specify encoded string as single positional argument")
+		}
+		opts.hash = cmd.Arg(0)
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	if opts.alphaName == "" {
+		return nil
+	}
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s encodes lists of base 10 integers as Hashids-compatible strings\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s encode [flags] NUM...\n", cmd.Name())
+		fmt.Printf("  %s decode [flags] HASH\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  NUM\tnon-negative integer to encode (one or more required)\n")
+		fmt.Printf("  HASH\tstring to decode (required)\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
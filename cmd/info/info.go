@@ -0,0 +1,218 @@
+package info
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// Run executes the info (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("info", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the info (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("info", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	bitsPerSymbol := math.Log2(float64(opts.base))
+
+	fmt.Printf("base:              %d\n", opts.base)
+	fmt.Printf("alphabet:          %s (%s)\n", opts.alphaName, opts.alpha)
+	fmt.Printf("bits per symbol:   %.4f\n", bitsPerSymbol)
+
+	if opts.maxDigits > 0 {
+		fmt.Printf("digits:            %d\n", opts.maxDigits)
+		fmt.Printf("max value:         %s\n", opts.maxNum)
+		fmt.Printf("total bits:        %.4f\n", bitsPerSymbol*float64(opts.maxDigits))
+
+		if opts.hasCurrent {
+			remaining := new(big.Int).Sub(opts.maxNum, new(big.Int).SetUint64(opts.current))
+			fmt.Printf("remaining values:  %s\n", remaining)
+		}
+	}
+
+	if opts.target != "" {
+		digits, err := baseconv.GetDigitsForCount(opts.targetCount, opts.base)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("target digits:     %d (for %s values)\n", digits, opts.target)
+	}
+
+	return nil
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	base      uint64
+	maxDigits uint64
+	alphaName string
+	current   uint64
+	target    string
+	profile   string
+
+	// derived from flags
+	alpha       *alphabet.Alphabet
+	hasCurrent  bool
+	maxNum      *big.Int
+	targetCount uint64
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.Uint64Var(&opts.base, "b", 0, "base of the encoding")
+	cmd.Uint64Var(&opts.base, "base", 0, "base of the encoding")
+
+	cmd.Uint64Var(&opts.maxDigits, "d", 0, "maximum number of digits used by the encoding")
+	cmd.Uint64Var(&opts.maxDigits, "digits", 0, "maximum number of digits used by the encoding")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used by the encoding")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used by the encoding")
+
+	cmd.Uint64Var(&opts.current, "c", 0, "current value of an ID counter, used to report the number of remaining values")
+	cmd.Uint64Var(&opts.current, "current", 0, "current value of an ID counter, used to report the number of remaining values")
+
+	cmd.StringVar(&opts.target, "t", "", "target count of values (e.g., 1000000 or 10^12), used to report the number of digits required")
+	cmd.StringVar(&opts.target, "target", "", "target count of values (e.g., 1000000 or 10^12), used to report the number of digits required")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	if len(args) == 0 {
+		return errNoArgs
+	}
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	if cmd.NArg() != 0 {
+		return errors.New("info does not accept positional arguments")
+	}
+	cmd.Visit(func(f *flag.Flag) {
+		if f.Name == "c" || f.Name == "current" {
+			opts.hasCurrent = true
+		}
+	})
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	maxBase := alpha.Len()
+	if opts.base > maxBase {
+		return fmt.Errorf("base [%d] exceeds alphabet size [%d]", opts.base, maxBase)
+	}
+	if opts.base < 2 {
+		return errors.New("base cannot be less than 2")
+	}
+
+	if opts.maxDigits > 0 {
+		// GetLargestBase10 is capped at math.MaxUint64, so the exact value is computed in arbitrary precision
+		opts.maxNum, err = baseconv.GetLargestBase10Big(opts.base, opts.maxDigits)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.hasCurrent {
+		if opts.maxDigits == 0 {
+			return errors.New("must specify number of digits to report remaining values")
+		}
+		if opts.maxNum.Cmp(new(big.Int).SetUint64(opts.current)) < 0 {
+			return fmt.Errorf("current value %d cannot be encoded in base %d with %d digits", opts.current, opts.base, opts.maxDigits)
+		}
+	}
+
+	if opts.target != "" {
+		count, err := parseCount(opts.target)
+		if err != nil {
+			return err
+		}
+		opts.targetCount = count
+	}
+	return nil
+}
+
+// parseCount parses a count specified either as an integer or as an exponential of the form b^e
+func parseCount(str string) (uint64, error) {
+	parts := strings.Split(str, "^")
+	if len(parts) == 1 {
+		count, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse target %s as uint64", str)
+		}
+		return count, nil
+	}
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("could not parse target %s as an exponential of the form b^e", str)
+	}
+
+	b, berr := strconv.ParseUint(parts[0], 10, 64)
+	e, eerr := strconv.ParseUint(parts[1], 10, 64)
+	if berr != nil || eerr != nil {
+		return 0, fmt.Errorf("could not parse target %s as an exponential of the form b^e", str)
+	}
+	count := uint64(1)
+	for i := uint64(0); i < e; i++ {
+		hi, lo := bits.Mul64(count, b)
+		if hi != 0 {
+			return 0, fmt.Errorf("target %s exceeds the maximum uint64 value", str)
+		}
+		count = lo
+	}
+	return count, nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s describes the capacity of an encoding in an arbitrary base\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s [flags]\n\n", cmd.Name())
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
@@ -0,0 +1,69 @@
+package info
+
+import (
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func TestValidateOpts(t *testing.T) {
+	type testCase struct {
+		base     uint64
+		digits   uint64
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"base 62 with 7 digits": {
+			base:     62,
+			digits:   7,
+			expected: "3521614606207",
+		},
+		"base 62 with 12 digits exceeding max uint64": {
+			base:     62,
+			digits:   12,
+			expected: "3226266762397899821055",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			opts := &cmdOpts{base: test.base, maxDigits: test.digits, alphaName: alphabet.DefaultName}
+			if err := validateOpts(opts); err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if opts.maxNum.String() != test.expected {
+				t.Errorf("max value %s not equal to expected %s", opts.maxNum, test.expected)
+			}
+		})
+	}
+}
+
+func TestValidateOptsWithError(t *testing.T) {
+	type testCase struct {
+		opts *cmdOpts
+	}
+
+	tests := map[string]testCase{
+		"digits exceeding limit": {
+			opts: &cmdOpts{base: 62, maxDigits: 1_000_000_000},
+		},
+		"current exceeding max value": {
+			opts: &cmdOpts{base: 62, maxDigits: 2, current: 3844, hasCurrent: true},
+		},
+		"current without digits": {
+			opts: &cmdOpts{base: 62, current: 1, hasCurrent: true},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test.opts.alphaName = alphabet.DefaultName
+			if err := validateOpts(test.opts); err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// Dispatcher executes a top level command with the specified arguments
+type Dispatcher func(args []string) error
+
+// Run executes the repl (sub)command, evaluating each input line by dispatching
+// to the encode or decode command with the session's settings
+func Run(args []string, dispatch Dispatcher) error {
+	cmd := flag.NewFlagSet("repl", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err != nil {
+		return err
+	}
+
+	return run(opts, dispatch)
+}
+
+// Flags returns the flags of the repl (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("repl", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts, dispatch Dispatcher) error {
+	fmt.Println("enter a base 10 integer to encode or a string to decode, :help for commands")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print(prompt)
+		if !scanner.Scan() {
+			fmt.Println()
+			return scanner.Err()
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line == ":quit" || line == ":exit" {
+			return nil
+		}
+
+		var err error
+		if strings.HasPrefix(line, ":") {
+			err = opts.setting(line)
+		} else {
+			err = dispatch(opts.commandArgs(line))
+		}
+		if err != nil {
+			fmt.Printf("error: %v\n", err)
+		}
+	}
+}
+
+const prompt = "> "
+
+// decimal matches input that is interpreted as a base 10 integer to encode
+var decimal = regexp.MustCompile(`^[0-9][0-9_]*$`)
+
+type cmdOpts struct {
+	// session settings, initialized by command flags
+	base      uint64
+	maxDigits uint64
+	pad       bool
+	alphaName string
+	profile   string
+}
+
+// commandArgs returns the arguments of the top level command used to evaluate an input line
+func (opts *cmdOpts) commandArgs(line string) []string {
+	base := strconv.FormatUint(opts.base, 10)
+	if decimal.MatchString(line) {
+		args := []string{
+			"encode",
+			"-b", base,
+			"-a", opts.alphaName,
+			"-p=" + strconv.FormatBool(opts.pad),
+			"-i", "10",
+			"-profile", opts.profile,
+		}
+		// encode limits the number of digits only when -d is set, as -d 0 allows only zero digits
+		if opts.maxDigits > 0 {
+			args = append(args, "-d", strconv.FormatUint(opts.maxDigits, 10))
+		}
+		return append(args, "--", line)
+	}
+	return []string{
+		"decode",
+		"-b", base,
+		"-a", opts.alphaName,
+		"-profile", opts.profile,
+		"--", line,
+	}
+}
+
+// setting evaluates a line of the form :name [value] that displays or changes a session setting
+func (opts *cmdOpts) setting(line string) error {
+	fields := strings.Fields(line)
+	name := fields[0]
+
+	switch name {
+	case ":help":
+		printHelp()
+		return nil
+	case ":show":
+		fmt.Printf("base:\t\t%d\nalphabet:\t%s\ndigits:\t\t%d\npad:\t\t%t\n", opts.base, opts.alphaName, opts.maxDigits, opts.pad)
+		return nil
+	}
+
+	if len(fields) != 2 {
+		return fmt.Errorf("%s requires a single value", name)
+	}
+	val := fields[1]
+
+	switch name {
+	case ":base", ":b":
+		base, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return fmt.Errorf("could not parse base %s as uint64", val)
+		}
+		opts.base = base
+	case ":digits", ":d":
+		digits, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return fmt.Errorf("could not parse digits %s as uint64", val)
+		}
+		opts.maxDigits = digits
+	case ":pad", ":p":
+		pad, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("could not parse pad %s as bool", val)
+		}
+		opts.pad = pad
+	case ":alphabet", ":a":
+		if _, err := alphabet.Get(val); err != nil {
+			return err
+		}
+		opts.alphaName = val
+	default:
+		return fmt.Errorf("unknown command %s", name)
+	}
+	return nil
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.Uint64Var(&opts.base, "b", 62, "initial base of the session")
+	cmd.Uint64Var(&opts.base, "base", 62, "initial base of the session")
+
+	cmd.Uint64Var(&opts.maxDigits, "d", 0, "initial maximum number of digits of the session (0 for no maximum)")
+	cmd.Uint64Var(&opts.maxDigits, "digits", 0, "initial maximum number of digits of the session (0 for no maximum)")
+
+	cmd.BoolVar(&opts.pad, "p", false, "initially pad encoded output to have exactly the number of specified digits")
+	cmd.BoolVar(&opts.pad, "pad", false, "initially pad encoded output to have exactly the number of specified digits")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of initial alphabet of the session")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of initial alphabet of the session")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	if cmd.NArg() != 0 {
+		return errors.New("repl does not accept positional arguments")
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	_, err := alphabet.Get(opts.alphaName)
+	return err
+}
+
+func printHelp() {
+	fmt.Print("Commands:\n")
+	fmt.Print("  :base N, :b N\t\tset the base\n")
+	fmt.Print("  :alphabet NAME, :a NAME\tset the alphabet\n")
+	fmt.Print("  :digits N, :d N\t\tset the maximum number of digits (0 for no maximum)\n")
+	fmt.Print("  :pad BOOL, :p BOOL\t\tset whether encoded output is padded to the number of digits\n")
+	fmt.Print("  :show\t\t\tshow the session settings\n")
+	fmt.Print("  :help\t\t\tshow this help\n")
+	fmt.Print("  :quit, :exit\t\tend the session\n")
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s starts an interactive session that encodes base 10 integers and decodes string representations\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s [flags]\n\n", cmd.Name())
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
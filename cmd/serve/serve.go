@@ -0,0 +1,146 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/pkg/server"
+)
+
+// Run executes the serve (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("serve", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the serve (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("serve", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	srv := &http.Server{
+		Addr:    opts.addr,
+		Handler: server.NewHandler(opts.limits),
+	}
+	return ListenAndServe(srv, opts.shutdownTimeout)
+}
+
+// ListenAndServe runs a server until it fails or the process receives an interrupt or termination
+// signal, after which the server is shut down gracefully within the specified timeout
+func ListenAndServe(srv *http.Server, shutdownTimeout time.Duration) error {
+	srv.ReadHeaderTimeout = 10 * time.Second
+	srv.ReadTimeout = 30 * time.Second
+	srv.WriteTimeout = 30 * time.Second
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		fmt.Printf("listening on %s\n", srv.Addr)
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	fmt.Println("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		return err
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+type cmdOpts struct {
+	// command flags
+	addr            string
+	maxInputLen     uint64
+	maxBatchLen     uint64
+	maxBodyBytes    uint64
+	shutdownTimeout time.Duration
+
+	// derived from flags
+	limits server.Limits
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.StringVar(&opts.addr, "addr", ":8080", "address on which to listen")
+
+	cmd.Uint64Var(&opts.maxInputLen, "max-input", uint64(server.DefaultLimits.MaxInputLen), "maximum length of a value to encode or decode, and of the digits parameter")
+	cmd.Uint64Var(&opts.maxBatchLen, "max-batch", uint64(server.DefaultLimits.MaxBatchLen), "maximum number of values in a batch request")
+	cmd.Uint64Var(&opts.maxBodyBytes, "max-body", uint64(server.DefaultLimits.MaxBodyBytes), "maximum size in bytes of a batch request body")
+
+	cmd.DurationVar(&opts.shutdownTimeout, "shutdown-timeout", 10*time.Second, "time allowed for in-flight requests to complete on shutdown")
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if cmd.NArg() != 0 {
+		return errors.New("serve does not accept positional arguments")
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	if opts.maxInputLen == 0 || opts.maxBatchLen == 0 || opts.maxBodyBytes == 0 {
+		return errors.New("limits must be greater than zero")
+	}
+	opts.limits = server.Limits{
+		MaxInputLen:  int(opts.maxInputLen),
+		MaxBatchLen:  int(opts.maxBatchLen),
+		MaxBodyBytes: int64(opts.maxBodyBytes),
+	}
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s serves encoding and decoding over HTTP\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s [flags]\n\n", cmd.Name())
+
+		fmt.Print("Endpoints:\n")
+		fmt.Print("  GET  /encode?n=NUM&base=BASE\tencodes a base 10 integer\n")
+		fmt.Print("  GET  /decode?s=STRINGREP&base=BASE\tdecodes a string representation\n")
+		fmt.Print("  POST /encode?base=BASE\tencodes each base 10 integer in a JSON array body\n")
+		fmt.Print("  POST /decode?base=BASE\tdecodes each string representation in a JSON array body\n")
+		fmt.Print("  optional parameters alphabet, digits and pad configure the conversion\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
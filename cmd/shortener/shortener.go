@@ -0,0 +1,118 @@
+package shortener
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/cmd/serve"
+	"github.com/dkaslovsky/baseconv/pkg/shortener"
+)
+
+// Run executes the shortener (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("shortener", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the shortener (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("shortener", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	var store shortener.Store = shortener.NewMemoryStore()
+	if opts.file != "" {
+		fileStore, err := shortener.OpenFileStore(opts.file)
+		if err != nil {
+			return err
+		}
+		defer fileStore.Close()
+		store = fileStore
+	}
+
+	baseURL := opts.baseURL
+	if baseURL == "" {
+		baseURL = "http://localhost" + opts.addr
+	}
+
+	h, err := shortener.NewHandler(store, opts.digits, baseURL)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:    opts.addr,
+		Handler: h,
+	}
+	return serve.ListenAndServe(srv, opts.shutdownTimeout)
+}
+
+type cmdOpts struct {
+	// command flags
+	addr            string
+	file            string
+	digits          uint64
+	baseURL         string
+	shutdownTimeout time.Duration
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.StringVar(&opts.addr, "addr", ":8080", "address on which to listen")
+	cmd.StringVar(&opts.file, "file", "", "file in which to persist URLs, held only in memory if not specified")
+
+	cmd.Uint64Var(&opts.digits, "d", 7, "number of base 62 digits in slugs")
+	cmd.Uint64Var(&opts.digits, "digits", 7, "number of base 62 digits in slugs")
+
+	cmd.StringVar(&opts.baseURL, "base-url", "", "URL prepended to slugs in responses (default http://localhost followed by addr)")
+	cmd.DurationVar(&opts.shutdownTimeout, "shutdown-timeout", 10*time.Second, "time allowed for in-flight requests to complete on shutdown")
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if cmd.NArg() != 0 {
+		return errors.New("shortener does not accept positional arguments")
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	if opts.digits == 0 {
+		return errors.New("number of digits must be greater than zero")
+	}
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s runs a URL shortener issuing base 62 slugs\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s [flags]\n\n", cmd.Name())
+
+		fmt.Print("Endpoints:\n")
+		fmt.Print("  POST /       stores the URL in the form value url and responds with its slug\n")
+		fmt.Print("  GET  /SLUG   redirects to the URL stored for the slug\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
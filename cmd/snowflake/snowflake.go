@@ -0,0 +1,216 @@
+package snowflake
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/snowflake"
+)
+
+// Actions are the actions of the snowflake (sub)command
+var Actions = []string{"new", "inspect"}
+
+// Run executes the snowflake (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("snowflake", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the snowflake (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("snowflake", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	if opts.action == "new" {
+		g, err := snowflake.NewGenerator(opts.layout, opts.worker)
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < opts.count; i++ {
+			id, err := g.New()
+			if err != nil {
+				return err
+			}
+			enc, err := opts.layout.Encode(id, opts.base, opts.alpha)
+			if err != nil {
+				return err
+			}
+			fmt.Println(enc)
+		}
+		return nil
+	}
+
+	id, err := parseID(opts)
+	if err != nil {
+		return err
+	}
+	parts, err := opts.layout.Split(id)
+	if err != nil {
+		return err
+	}
+	enc, err := opts.layout.Encode(id, opts.base, opts.alpha)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("id:        %d\n", id)
+	fmt.Printf("encoded:   %s\n", enc)
+	fmt.Printf("timestamp: %d\n", parts.Timestamp)
+	fmt.Printf("time:      %s\n", opts.layout.Time(parts.Timestamp).Format(time.RFC3339Nano))
+	fmt.Printf("worker:    %d\n", parts.Worker)
+	fmt.Printf("sequence:  %d\n", parts.Sequence)
+	return nil
+}
+
+// parseID parses the positional argument as a base 10 integer if it consists of decimal
+// digits and otherwise decodes it as an encoded string
+func parseID(opts *cmdOpts) (uint64, error) {
+	if !opts.encoded && strings.Trim(opts.id, "0123456789") == "" {
+		id, err := strconv.ParseUint(opts.id, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse positional argument %s as a 64-bit integer", opts.id)
+		}
+		return id, nil
+	}
+	return opts.layout.Decode(opts.id, opts.base, opts.alpha)
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	base         uint64
+	alphaName    string
+	epoch        int64
+	unit         time.Duration
+	timeBits     uint
+	workerBits   uint
+	sequenceBits uint
+	worker       uint64
+	count        uint64
+	encoded      bool
+	profile      string
+
+	// derived from flags
+	alpha  *alphabet.Alphabet
+	layout snowflake.Layout
+
+	// positional args
+	action string
+	id     string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.Uint64Var(&opts.base, "b", 62, "base of encoded IDs")
+	cmd.Uint64Var(&opts.base, "base", 62, "base of encoded IDs")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used for encoding")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used for encoding")
+
+	cmd.Int64Var(&opts.epoch, "epoch", snowflake.Twitter.Epoch.UnixMilli(), "Unix time in milliseconds from which timestamps are measured")
+	cmd.DurationVar(&opts.unit, "unit", snowflake.Twitter.Unit, "duration of a unit of timestamps")
+	cmd.UintVar(&opts.timeBits, "time-bits", snowflake.Twitter.TimeBits, "number of bits of timestamps")
+	cmd.UintVar(&opts.workerBits, "worker-bits", snowflake.Twitter.WorkerBits, "number of bits of workers")
+	cmd.UintVar(&opts.sequenceBits, "sequence-bits", snowflake.Twitter.SequenceBits, "number of bits of sequences")
+
+	cmd.Uint64Var(&opts.worker, "worker", 0, "worker of generated IDs")
+	cmd.Uint64Var(&opts.count, "n", 1, "number of IDs to generate")
+	cmd.BoolVar(&opts.encoded, "encoded", false, "decode the ID to inspect even if it consists of decimal digits")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	opts.action = args[0]
+	if opts.action != "new" && opts.action != "inspect" {
+		return fmt.Errorf("unknown snowflake action %s", opts.action)
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	if opts.action == "new" {
+		if cmd.NArg() != 0 {
+			return errors.New("snowflake new does not accept positional arguments")
+		}
+	} else {
+		if cmd.NArg() != 1 {
+			return errors.New("must specify ID to inspect as single positional argument")
+		}
+		opts.id = cmd.Arg(0)
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	maxBase := alpha.Len()
+	if opts.base > maxBase {
+		return fmt.Errorf("base [%d] exceeds alphabet size [%d]", opts.base, maxBase)
+	}
+
+	opts.layout = snowflake.Layout{
+		Epoch:        time.UnixMilli(opts.epoch),
+		Unit:         opts.unit,
+		TimeBits:     opts.timeBits,
+		WorkerBits:   opts.workerBits,
+		SequenceBits: opts.sequenceBits,
+	}
+	return opts.layout.Validate()
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s generates and inspects Snowflake IDs of timestamp, worker and sequence bit fields\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s new [flags]\n", cmd.Name())
+		fmt.Printf("  %s inspect [flags] ID\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  ID\tbase 10 integer or encoded string of the ID to inspect (required)\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
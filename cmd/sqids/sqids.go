@@ -0,0 +1,184 @@
+package sqids
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/sqids"
+)
+
+// Actions are the actions of the sqids (sub)command
+var Actions = []string{"encode", "decode"}
+
+// Run executes the sqids (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("sqids", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the sqids (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("sqids", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	sqOpts := sqids.Options{
+		Alphabet:  opts.alpha,
+		MinLength: uint8(opts.minLength),
+	}
+	if opts.noBlocklist {
+		sqOpts.Blocklist = []string{}
+	}
+	s, err := sqids.New(sqOpts)
+	if err != nil {
+		return err
+	}
+
+	if opts.action == "encode" {
+		enc, err := s.Encode(opts.nums)
+		if err != nil {
+			return err
+		}
+		fmt.Println(enc)
+		return nil
+	}
+
+	nums, err := s.Decode(opts.id)
+	if err != nil {
+		return err
+	}
+	if len(nums) == 0 {
+		return fmt.Errorf("%s is not a valid Sqid for the alphabet", opts.id)
+	}
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.FormatUint(n, 10)
+	}
+	fmt.Println(strings.Join(strs, " "))
+	return nil
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	minLength   uint64
+	alphaName   string
+	noBlocklist bool
+	profile     string
+
+	// derived from flags
+	alpha *alphabet.Alphabet
+
+	// positional args
+	action string
+	nums   []uint64
+	id     string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.Uint64Var(&opts.minLength, "min-length", 0, "minimum length of encoded strings (at most 255)")
+
+	cmd.StringVar(&opts.alphaName, "a", "", "name of alphabet used for encoding (default Sqids alphabet)")
+	cmd.StringVar(&opts.alphaName, "alphabet", "", "name of alphabet used for encoding (default Sqids alphabet)")
+
+	cmd.BoolVar(&opts.noBlocklist, "no-blocklist", false, "allow encoded strings containing words of the default blocklist")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	opts.action = args[0]
+	if opts.action != "encode" && opts.action != "decode" {
+		return fmt.Errorf("unknown sqids action %s", opts.action)
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	if opts.action == "encode" {
+		if cmd.NArg() == 0 {
+			return errors.New("must specify one or more base 10 integers to encode as positional arguments")
+		}
+		for _, arg := range cmd.Args() {
+			num, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return fmt.Errorf("could not parse positional argument %s as a non-negative integer", arg)
+			}
+			opts.nums = append(opts.nums, num)
+		}
+	} else {
+		if cmd.NArg() != 1 {
+			return errors.New("must specify encoded string as single positional argument")
+		}
+		opts.id = cmd.Arg(0)
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	if opts.minLength > math.MaxUint8 {
+		return fmt.Errorf("minimum length [%d] cannot exceed %d", opts.minLength, math.MaxUint8)
+	}
+	if opts.alphaName == "" {
+		return nil
+	}
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s encodes lists of base 10 integers as Sqids\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s encode [flags] NUM...\n", cmd.Name())
+		fmt.Printf("  %s decode [flags] ID\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  NUM\tnon-negative integer to encode (one or more required)\n")
+		fmt.Printf("  ID\tstring to decode (required)\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
@@ -0,0 +1,211 @@
+package table
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// Run executes the table (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("table", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the table (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("table", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if opts.reverse {
+		fmt.Fprintln(w, "BASE\tVALUE")
+		for _, base := range opts.bases {
+			val, ok := decode(opts.numeric, base)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(w, "%d\t%s\n", base, val)
+		}
+		return w.Flush()
+	}
+
+	fmt.Fprintln(w, "BASE\tENCODING")
+	for _, base := range opts.bases {
+		enc, err := baseconv.FromBase10Any(opts.num, base)
+		if err != nil {
+			return err
+		}
+		str, serr := opts.alpha.ToString(enc)
+		if serr != nil {
+			return serr
+		}
+		fmt.Fprintf(w, "%d\t%s\n", base, str)
+	}
+	return w.Flush()
+}
+
+// decode returns the base 10 value of a numeric representation in the specified base as a string
+// and false if the representation is not a valid encoding in the base
+func decode(numeric []uint64, base uint64) (string, bool) {
+	dec, err := baseconv.ToBase10Any(numeric, base)
+	if err != nil {
+		return "", false
+	}
+	return dec.String(), true
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	baseList  string
+	alphaName string
+	reverse   bool
+	profile   string
+
+	// positional args
+	arg string
+
+	// derived from flags and args
+	alpha   *alphabet.Alphabet
+	bases   []uint64
+	num     *big.Int
+	numeric []uint64
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.StringVar(&opts.baseList, "b", "", "comma separated list of bases to include (default all bases supported by the alphabet)")
+	cmd.StringVar(&opts.baseList, "bases", "", "comma separated list of bases to include (default all bases supported by the alphabet)")
+
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used for encoding")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used for encoding")
+
+	cmd.BoolVar(&opts.reverse, "r", false, "decode the input string in every base in which it is a valid encoding")
+	cmd.BoolVar(&opts.reverse, "reverse", false, "decode the input string in every base in which it is a valid encoding")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	if len(args) == 0 {
+		return errNoArgs
+	}
+	err := cmd.Parse(args)
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	if cmd.NArg() != 1 {
+		return errors.New("must specify base 10 integer or encoded string as single positional argument")
+	}
+	opts.arg = cmd.Arg(0)
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	bases, err := parseBases(opts.baseList, alpha.Len())
+	if err != nil {
+		return err
+	}
+	opts.bases = bases
+
+	if opts.reverse {
+		numeric, err := alpha.FromString(opts.arg)
+		if err != nil {
+			return err
+		}
+		opts.numeric = numeric
+		return nil
+	}
+
+	num, ok := new(big.Int).SetString(opts.arg, 10)
+	if !ok || num.Sign() < 0 {
+		return fmt.Errorf("could not parse positional argument %s as a non-negative integer", opts.arg)
+	}
+	opts.num = num
+	return nil
+}
+
+// parseBases parses a comma separated list of bases, returning all bases supported by
+// an alphabet of the specified size if the list is empty
+func parseBases(baseList string, maxBase uint64) ([]uint64, error) {
+	bases := []uint64{}
+	if baseList == "" {
+		for base := uint64(2); base <= maxBase; base++ {
+			bases = append(bases, base)
+		}
+		return bases, nil
+	}
+
+	for _, b := range strings.Split(baseList, ",") {
+		base, err := strconv.ParseUint(strings.TrimSpace(b), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse base %s as uint64", b)
+		}
+		if base < 2 {
+			return nil, errors.New("base cannot be less than 2")
+		}
+		if base > maxBase {
+			return nil, fmt.Errorf("base [%d] exceeds alphabet size [%d]", base, maxBase)
+		}
+		bases = append(bases, base)
+	}
+	return bases, nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s prints the representation of a base 10 integer in multiple bases\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s [flags] NUM\n", cmd.Name())
+		fmt.Printf("  %s -r [flags] STRINGREP\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  NUM\t\tnon-negative base 10 integer of arbitrary size to encode\n")
+		fmt.Printf("  STRINGREP\tstring representation to decode in reverse mode\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
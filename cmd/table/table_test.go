@@ -0,0 +1,72 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func TestDecode(t *testing.T) {
+	type testCase struct {
+		str      string
+		base     uint64
+		expected string
+		ok       bool
+	}
+
+	tests := map[string]testCase{
+		"value within uint64": {
+			str:      "hBxM5A5",
+			base:     62,
+			expected: "1000000000001",
+			ok:       true,
+		},
+		"value exceeding uint64": {
+			str:      "uZIZSVfnEVXFe",
+			base:     62,
+			expected: "100000000000000000000000",
+			ok:       true,
+		},
+		"invalid encoding in base": {
+			str:  "hBxM5A5",
+			base: 36,
+			ok:   false,
+		},
+	}
+
+	alpha, err := alphabet.Get(alphabet.DefaultName)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			numeric, err := alpha.FromString(test.str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			res, ok := decode(numeric, test.base)
+			if ok != test.ok {
+				t.Fatalf("ok %t not equal to expected %t", ok, test.ok)
+			}
+			if res != test.expected {
+				t.Errorf("result %s not equal to expected %s", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestValidateOptsWithLargeInteger(t *testing.T) {
+	opts := &cmdOpts{
+		baseList:  "62",
+		alphaName: alphabet.DefaultName,
+		arg:       "100000000000000000000000",
+	}
+	if err := validateOpts(opts); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if opts.num.String() != opts.arg {
+		t.Errorf("num %s not equal to expected %s", opts.num, opts.arg)
+	}
+}
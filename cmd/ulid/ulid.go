@@ -0,0 +1,133 @@
+package ulid
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/ulid"
+)
+
+// Actions are the actions of the ulid (sub)command
+var Actions = []string{"new", "inspect"}
+
+// Run executes the ulid (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("ulid", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the ulid (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("ulid", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	if opts.action == "new" {
+		g := ulid.NewGenerator()
+		for i := uint64(0); i < opts.count; i++ {
+			u, err := g.New()
+			if err != nil {
+				return err
+			}
+			fmt.Println(u)
+		}
+		return nil
+	}
+
+	u, err := ulid.Parse(opts.id)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("ulid:      %s\n", u)
+	fmt.Printf("timestamp: %d\n", u.Timestamp())
+	fmt.Printf("time:      %s\n", u.Time().Format(time.RFC3339Nano))
+	fmt.Printf("entropy:   %s\n", u.Entropy())
+	return nil
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	count   uint64
+	profile string
+
+	// positional args
+	action string
+	id     string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.Uint64Var(&opts.count, "n", 1, "number of monotonically increasing ULIDs to generate")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	opts.action = args[0]
+	if opts.action != "new" && opts.action != "inspect" {
+		return fmt.Errorf("unknown ulid action %s", opts.action)
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	if opts.action == "new" {
+		if cmd.NArg() != 0 {
+			return errors.New("ulid new does not accept positional arguments")
+		}
+		return nil
+	}
+	if cmd.NArg() != 1 {
+		return errors.New("must specify ULID to inspect as single positional argument")
+	}
+	opts.id = cmd.Arg(0)
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s generates and inspects ULIDs, 26-character Crockford base 32 identifiers sortable by time\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s new [flags]\n", cmd.Name())
+		fmt.Printf("  %s inspect [flags] ULID\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  ULID\tULID to inspect (required)\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
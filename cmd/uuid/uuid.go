@@ -0,0 +1,171 @@
+package uuid
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/dkaslovsky/baseconv/cmd/config"
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+	"github.com/dkaslovsky/baseconv/pkg/uuid"
+)
+
+// Actions are the actions of the uuid (sub)command
+var Actions = []string{"encode", "decode", "new"}
+
+// Run executes the uuid (sub)command
+func Run(args []string) error {
+	cmd := flag.NewFlagSet("uuid", flag.ExitOnError)
+	opts := &cmdOpts{}
+	attachOpts(cmd, opts)
+	setUsage(cmd)
+
+	err := parseArgs(cmd, opts, args)
+	if err == errNoArgs {
+		cmd.Usage()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return run(opts)
+}
+
+// Flags returns the flags of the uuid (sub)command
+func Flags() *flag.FlagSet {
+	cmd := flag.NewFlagSet("uuid", flag.ContinueOnError)
+	attachOpts(cmd, &cmdOpts{})
+	return cmd
+}
+
+func run(opts *cmdOpts) error {
+	switch opts.action {
+	case "encode":
+		u, err := uuid.Parse(opts.arg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(baseconv.EncodeUUIDWithAlphabet(u, opts.alpha))
+
+	case "decode":
+		u, err := baseconv.DecodeUUIDWithAlphabet(opts.arg, opts.alpha)
+		if err != nil {
+			return err
+		}
+		fmt.Println(uuid.UUID(u))
+
+	case "new":
+		var u uuid.UUID
+		var err error
+		if opts.version == 7 {
+			u, err = uuid.NewV7()
+		} else {
+			u, err = uuid.NewV4()
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Println(baseconv.EncodeUUIDWithAlphabet(u, opts.alpha))
+	}
+	return nil
+}
+
+// errorNoArgs is returned when no arguments are passed to the command
+var errNoArgs = errors.New("missing required argument(s)")
+
+type cmdOpts struct {
+	// command flags
+	alphaName string
+	version   uint64
+	profile   string
+
+	// derived from flags
+	alpha *alphabet.Alphabet
+
+	// positional args
+	action string
+	arg    string
+}
+
+func attachOpts(cmd *flag.FlagSet, opts *cmdOpts) {
+	cmd.StringVar(&opts.alphaName, "a", alphabet.DefaultName, "name of alphabet used for encoding in the base of its size")
+	cmd.StringVar(&opts.alphaName, "alphabet", alphabet.DefaultName, "name of alphabet used for encoding in the base of its size")
+
+	cmd.Uint64Var(&opts.version, "version", 4, "version of generated UUIDs, 4 (random) or 7 (timestamp and random)")
+
+	config.AttachProfile(cmd, &opts.profile)
+}
+
+func parseArgs(cmd *flag.FlagSet, opts *cmdOpts, args []string) error {
+	// a help flag in place of the action prints the usage
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		return errNoArgs
+	}
+
+	// handle the action preceding the flags
+	opts.action = args[0]
+	if opts.action != "encode" && opts.action != "decode" && opts.action != "new" {
+		return fmt.Errorf("unknown uuid action %s", opts.action)
+	}
+	err := cmd.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	err = config.Apply(cmd, opts.profile)
+	if err != nil {
+		return err
+	}
+
+	// handle positional argument(s)
+	switch opts.action {
+	case "encode":
+		if cmd.NArg() != 1 {
+			return errors.New("must specify UUID to encode as single positional argument")
+		}
+		opts.arg = cmd.Arg(0)
+	case "decode":
+		if cmd.NArg() != 1 {
+			return errors.New("must specify encoded UUID as single positional argument")
+		}
+		opts.arg = cmd.Arg(0)
+	case "new":
+		if cmd.NArg() != 0 {
+			return errors.New("uuid new does not accept positional arguments")
+		}
+	}
+
+	return validateOpts(opts)
+}
+
+func validateOpts(opts *cmdOpts) error {
+	alpha, err := alphabet.Get(opts.alphaName)
+	if err != nil {
+		return err
+	}
+	opts.alpha = alpha
+
+	if opts.version != 4 && opts.version != 7 {
+		return fmt.Errorf("UUID version [%d] must be 4 or 7", opts.version)
+	}
+	return nil
+}
+
+func setUsage(cmd *flag.FlagSet) {
+	cmd.Usage = func() {
+		fmt.Printf("%s encodes UUIDs as fixed-width strings in the base of the size of an alphabet\n\n", cmd.Name())
+
+		fmt.Print("Usage:\n")
+		fmt.Printf("  %s encode [flags] UUID\n", cmd.Name())
+		fmt.Printf("  %s decode [flags] STRINGREP\n", cmd.Name())
+		fmt.Printf("  %s new [flags]\n\n", cmd.Name())
+
+		fmt.Print("Args:\n")
+		fmt.Printf("  UUID\tUUID in canonical hyphenated form to encode (required)\n")
+		fmt.Printf("  STRINGREP\tencoded UUID to decode (required)\n\n")
+
+		fmt.Printf("Flags:\n")
+		cmd.PrintDefaults()
+	}
+}
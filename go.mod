@@ -1,3 +1,3 @@
 module github.com/dkaslovsky/baseconv
 
-go 1.16
+go 1.19
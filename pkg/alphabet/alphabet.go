@@ -2,16 +2,75 @@ package alphabet
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
 const alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
+// DefaultName is the name of the default alphabet
+const DefaultName = "base62"
+
+// named maps the names of the predefined alphabets to their characters
+var named = map[string]string{
+	DefaultName: alphabet,
+	"ascii62":   "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	"base36":    "0123456789abcdefghijklmnopqrstuvwxyz",
+	"base58":    "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+	"crockford": "0123456789ABCDEFGHJKMNPQRSTVWXYZ",
+	"shortuuid": "23456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+}
+
+// Default is the alphabet used by the package level functions
+var Default = &Alphabet{chars: alphabet}
+
+// Alphabet is an ordered set of characters in which the character at index i represents the digit i
+type Alphabet struct {
+	chars string
+}
+
+// New creates an Alphabet from a string of unique ASCII characters
+func New(chars string) (*Alphabet, error) {
+	if len(chars) < 2 {
+		return nil, fmt.Errorf("alphabet must contain at least 2 characters")
+	}
+	seen := map[rune]bool{}
+	for _, c := range chars {
+		if c > 127 {
+			return nil, fmt.Errorf("character [%c] is not ASCII", c)
+		}
+		if seen[c] {
+			return nil, fmt.Errorf("character [%c] is repeated in alphabet", c)
+		}
+		seen[c] = true
+	}
+	return &Alphabet{chars: chars}, nil
+}
+
+// Get returns the predefined alphabet with the specified name
+func Get(name string) (*Alphabet, error) {
+	chars, ok := named[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown alphabet [%s], must be one of [%s]", name, strings.Join(Names(), ", "))
+	}
+	return &Alphabet{chars: chars}, nil
+}
+
+// Names returns the sorted names of the predefined alphabets
+func Names() []string {
+	names := []string{}
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // FromString converts a string of characters to a slice of corresponding numbers
-func FromString(str string) ([]uint64, error) {
+func (a *Alphabet) FromString(str string) ([]uint64, error) {
 	numeric := []uint64{}
 	for _, s := range str {
-		i := strings.Index(alphabet, string(s))
+		i := strings.IndexRune(a.chars, s)
 		if i == -1 {
 			return numeric, fmt.Errorf("character [%c] not found in alphabet", s)
 		}
@@ -21,33 +80,76 @@ func FromString(str string) ([]uint64, error) {
 }
 
 // ToString converts a slice of numbers to a string of corresponding characters
-func ToString(numeric []uint64) (string, error) {
+func (a *Alphabet) ToString(numeric []uint64) (string, error) {
 	str := []byte{}
 	for _, n := range numeric {
-		if n >= Len() {
-			return "", fmt.Errorf("value [%d] cannot be represented in alphabet of size [%d]", n, Len())
+		if n >= a.Len() {
+			return "", fmt.Errorf("value [%d] cannot be represented in alphabet of size [%d]", n, a.Len())
 		}
-		str = append(str, alphabet[n])
+		str = append(str, a.chars[n])
 	}
 	return string(str), nil
 }
 
 // Pad appends the zero character of the alphabet to a string to produce a string of desired length
-func Pad(str string, strLen int) (string, error) {
+func (a *Alphabet) Pad(str string, strLen int) (string, error) {
 	padLen := strLen - len(str)
 	if padLen < 0 {
 		return "", fmt.Errorf("input string length [%d] exceeds desired padded length [%d]", len(str), strLen)
 	}
-	padding := strings.Repeat(Zero(), padLen)
+	padding := strings.Repeat(a.Zero(), padLen)
 	return padding + str, nil
 }
 
 // Len returns the length of the alphabet
-func Len() uint64 {
-	return uint64(len(alphabet))
+func (a *Alphabet) Len() uint64 {
+	return uint64(len(a.chars))
 }
 
 // Zero returns the alphabet's index-zero character used for padding a string
+func (a *Alphabet) Zero() string {
+	return string(a.chars[0])
+}
+
+// IsSorted reports whether the characters of the alphabet are in ASCII order, in which case the
+// byte order of equal-length strings matches the order of the numbers they represent
+func (a *Alphabet) IsSorted() bool {
+	for i := 1; i < len(a.chars); i++ {
+		if a.chars[i-1] > a.chars[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the characters of the alphabet
+func (a *Alphabet) String() string {
+	return a.chars
+}
+
+// FromString converts a string of characters to a slice of corresponding numbers
+// using the default alphabet
+func FromString(str string) ([]uint64, error) {
+	return Default.FromString(str)
+}
+
+// ToString converts a slice of numbers to a string of corresponding characters
+// using the default alphabet
+func ToString(numeric []uint64) (string, error) {
+	return Default.ToString(numeric)
+}
+
+// Pad appends the zero character of the default alphabet to a string to produce a string of desired length
+func Pad(str string, strLen int) (string, error) {
+	return Default.Pad(str, strLen)
+}
+
+// Len returns the length of the default alphabet
+func Len() uint64 {
+	return Default.Len()
+}
+
+// Zero returns the default alphabet's index-zero character used for padding a string
 func Zero() string {
-	return string(alphabet[0])
+	return Default.Zero()
 }
@@ -214,3 +214,125 @@ func TestPadWithError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithError(t *testing.T) {
+	type testCase struct {
+		chars string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			chars: "",
+		},
+		"single character": {
+			chars: "a",
+		},
+		"repeated character": {
+			chars: "abca",
+		},
+		"non ASCII character": {
+			chars: "abcé",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := New(test.chars)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	type testCase struct {
+		name        string
+		str         string
+		expected    []uint64
+		expectedLen uint64
+	}
+
+	tests := map[string]testCase{
+		"default": {
+			name:        DefaultName,
+			str:         "aZ",
+			expected:    []uint64{10, 61},
+			expectedLen: 62,
+		},
+		"base58": {
+			name:        "base58",
+			str:         "1A",
+			expected:    []uint64{0, 9},
+			expectedLen: 58,
+		},
+		"crockford": {
+			name:        "crockford",
+			str:         "0Z",
+			expected:    []uint64{0, 31},
+			expectedLen: 32,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			a, err := Get(test.name)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if a.Len() != test.expectedLen {
+				t.Errorf("length %d not equal to expected %d", a.Len(), test.expectedLen)
+			}
+			res, err := a.FromString(test.str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(res) != len(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+				return
+			}
+			for i := 0; i < len(res); i++ {
+				if res[i] != test.expected[i] {
+					t.Errorf("result %v not equal to expected %v", res, test.expected)
+					return
+				}
+			}
+			str, err := a.ToString(res)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if str != test.str {
+				t.Errorf("result %s not equal to expected %s", str, test.str)
+			}
+		})
+	}
+}
+
+func TestGetWithError(t *testing.T) {
+	_, err := Get("unknown")
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	expected := map[string]bool{
+		DefaultName: false,
+		"ascii62":   true,
+		"base36":    true,
+		"base58":    true,
+		"crockford": true,
+		"shortuuid": true,
+	}
+	for _, name := range Names() {
+		a, err := Get(name)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if a.IsSorted() != expected[name] {
+			t.Errorf("sorted %t for alphabet %s not equal to expected %t", a.IsSorted(), name, expected[name])
+		}
+	}
+}
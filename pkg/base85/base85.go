@@ -0,0 +1,131 @@
+package base85
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+const (
+	// groupBytes is the number of bytes of a group encoded as groupChars characters
+	groupBytes = 4
+	groupChars = 5
+)
+
+const (
+	ascii85Chars = "!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstu"
+	rfc1924Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!#$%&()*+-;<=>?@^_`{|}~"
+	z85Chars     = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+)
+
+var (
+	// Ascii85 is the encoding of PostScript and PDF, delimited by <~ and ~> and encoding a group of
+	// four zero bytes as z
+	Ascii85 = newEncoding(ascii85Chars, true, true, true)
+	// RFC1924 is the encoding with the alphabet of RFC 1924, as used by Git binary patches
+	RFC1924 = newEncoding(rfc1924Chars, false, false, true)
+	// Z85 is the encoding of ZeroMQ, which requires a number of bytes divisible by four
+	Z85 = newEncoding(z85Chars, false, false, false)
+)
+
+// named maps the names of the encodings to the encodings
+var named = map[string]*Encoding{
+	"ascii85": Ascii85,
+	"rfc1924": RFC1924,
+	"z85":     Z85,
+}
+
+// Encoding is a base 85 encoding of each group of four bytes as five characters of an alphabet,
+// the digits of the group as a big-endian integer
+type Encoding struct {
+	alpha *alphabet.Alphabet
+	// zero encodes a group of four zero bytes as z
+	zero bool
+	// delimit surrounds encoded strings with <~ and ~>
+	delimit bool
+	// partial allows a final group of fewer than four bytes, which is padded with zero bytes and
+	// encoded by the one more character than its number of bytes
+	partial bool
+}
+
+func newEncoding(chars string, zero bool, delimit bool, partial bool) *Encoding {
+	alpha, err := alphabet.New(chars)
+	if err != nil || alpha.Len() != 85 {
+		panic(fmt.Sprintf("invalid base 85 alphabet [%s]", chars))
+	}
+	return &Encoding{alpha: alpha, zero: zero, delimit: delimit, partial: partial}
+}
+
+// Get returns the encoding with the specified name
+func Get(name string) (*Encoding, error) {
+	enc, ok := named[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown base 85 encoding [%s], must be one of [%s]", name, strings.Join(Names(), ", "))
+	}
+	return enc, nil
+}
+
+// Names returns the sorted names of the encodings
+func Names() []string {
+	names := []string{}
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Alphabet returns the alphabet of the encoding
+func (e *Encoding) Alphabet() *alphabet.Alphabet {
+	return e.alpha
+}
+
+// EncodeToString returns the encoding of src
+func (e *Encoding) EncodeToString(src []byte) (string, error) {
+	if !e.partial && len(src)%groupBytes != 0 {
+		return "", fmt.Errorf("number of bytes [%d] must be divisible by %d", len(src), groupBytes)
+	}
+	sb := strings.Builder{}
+	w := NewEncoder(e, &sb)
+	if _, err := w.Write(src); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// DecodeString returns the bytes encoded by str; the delimiters of a delimited encoding are
+// optional and whitespace is ignored by an encoding with the z shortcut
+func (e *Encoding) DecodeString(str string) ([]byte, error) {
+	return io.ReadAll(NewDecoder(e, strings.NewReader(str)))
+}
+
+// EncodeIPv6 returns the RFC 1924 encoding of an IPv6 address as a 128-bit integer in 20 characters
+func EncodeIPv6(addr netip.Addr) (string, error) {
+	if !addr.Is6() {
+		return "", fmt.Errorf("address [%s] is not an IPv6 address", addr)
+	}
+	b := addr.As16()
+	return baseconv.EncodeBytes(b[:], RFC1924.alpha), nil
+}
+
+// DecodeIPv6 returns the IPv6 address encoded by a 20-character RFC 1924 string
+func DecodeIPv6(str string) (netip.Addr, error) {
+	b, err := baseconv.DecodeBytes(str, 16, RFC1924.alpha)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	addr, ok := netip.AddrFromSlice(b)
+	if !ok {
+		return netip.Addr{}, errors.New("invalid IPv6 address")
+	}
+	return addr, nil
+}
@@ -0,0 +1,236 @@
+package base85
+
+import (
+	"bytes"
+	"encoding/ascii85"
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+func TestEncodeAndDecode(t *testing.T) {
+	type testCase struct {
+		enc      *Encoding
+		src      []byte
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"ascii85": {
+			enc:      Ascii85,
+			src:      []byte("Man is distinguished"),
+			expected: "<~9jqo^BlbD-BleB1DJ+*+F(f,q~>",
+		},
+		"ascii85 zero group and partial group": {
+			enc:      Ascii85,
+			src:      []byte("\x00\x00\x00\x00ab"),
+			expected: "<~z@:B~>",
+		},
+		"ascii85 partial zero group": {
+			enc:      Ascii85,
+			src:      []byte{0, 0},
+			expected: "<~!!!~>",
+		},
+		"ascii85 empty": {
+			enc:      Ascii85,
+			src:      []byte{},
+			expected: "<~~>",
+		},
+		"rfc1924": {
+			enc:      RFC1924,
+			src:      []byte("hello world"),
+			expected: "Xk~0{Zy<MXa%^M",
+		},
+		"rfc1924 zero group": {
+			enc:      RFC1924,
+			src:      []byte{0, 0, 0, 0},
+			expected: "00000",
+		},
+		"z85": {
+			enc:      Z85,
+			src:      []byte{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b},
+			expected: "HelloWorld",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := test.enc.EncodeToString(test.src)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res != test.expected {
+				t.Errorf("encoding %s not equal to expected %s", res, test.expected)
+			}
+			dec, err := test.enc.DecodeString(res)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, test.src) {
+				t.Errorf("decoding %x not equal to expected %x", dec, test.src)
+			}
+		})
+	}
+}
+
+func TestAscii85MatchesStandardLibrary(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for size := 0; size < 100; size++ {
+		src := make([]byte, size)
+		r.Read(src)
+		// runs of zero bytes exercise the z shortcut
+		if size > 8 {
+			copy(src[4:8], []byte{0, 0, 0, 0})
+		}
+
+		dst := make([]byte, ascii85.MaxEncodedLen(size))
+		expected := "<~" + string(dst[:ascii85.Encode(dst, src)]) + "~>"
+		res, err := Ascii85.EncodeToString(src)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if res != expected {
+			t.Fatalf("encoding %s of %x not equal to expected %s", res, src, expected)
+		}
+
+		dec, err := Ascii85.DecodeString(res)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if !bytes.Equal(dec, src) {
+			t.Fatalf("decoding %x not equal to expected %x", dec, src)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, name := range Names() {
+		enc, err := Get(name)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		for size := 0; size < 100; size++ {
+			if enc == Z85 && size%4 != 0 {
+				continue
+			}
+			src := make([]byte, size)
+			r.Read(src)
+			str, err := enc.EncodeToString(src)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			dec, err := enc.DecodeString(str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, src) {
+				t.Fatalf("%s decoding %x not equal to expected %x", name, dec, src)
+			}
+		}
+	}
+}
+
+func TestDecodeAscii85Leniently(t *testing.T) {
+	for _, str := range []string{"9jqo^BlbD-BleB1DJ+*+F(f,q", " <~9jqo^BlbD-\nBleB1DJ+*+F(f,q~>\n"} {
+		dec, err := Ascii85.DecodeString(str)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if string(dec) != "Man is distinguished" {
+			t.Errorf("decoding %s not equal to expected %s", dec, "Man is distinguished")
+		}
+	}
+}
+
+func TestEncodeWithError(t *testing.T) {
+	_, err := Z85.EncodeToString([]byte{1, 2, 3})
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestDecodeWithError(t *testing.T) {
+	type testCase struct {
+		enc *Encoding
+		str string
+	}
+
+	tests := map[string]testCase{
+		"ascii85 single character final group": {
+			enc: Ascii85,
+			str: "<~9jqo^B~>",
+		},
+		"ascii85 character not in alphabet": {
+			enc: Ascii85,
+			str: "<~9jqo^v~>",
+		},
+		"ascii85 group overflow": {
+			enc: Ascii85,
+			str: "<~uuuuu~>",
+		},
+		"rfc1924 character not in alphabet": {
+			enc: RFC1924,
+			str: "Xk~0{Zy<MX\"",
+		},
+		"z85 partial group": {
+			enc: Z85,
+			str: "HelloWor",
+		},
+		"z85 z shortcut": {
+			enc: Z85,
+			str: "z",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := test.enc.DecodeString(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestEncodeAndDecodeIPv6(t *testing.T) {
+	addr := netip.MustParseAddr("1080:0:0:0:8:800:200C:417A")
+	str, err := EncodeIPv6(addr)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if str != "4)+k&C#VzJ4br>0wv%Yp" {
+		t.Errorf("encoding %s not equal to expected %s", str, "4)+k&C#VzJ4br>0wv%Yp")
+	}
+	dec, err := DecodeIPv6(str)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if dec != addr {
+		t.Errorf("decoding %s not equal to expected %s", dec, addr)
+	}
+}
+
+func TestIPv6WithError(t *testing.T) {
+	_, err := EncodeIPv6(netip.MustParseAddr("127.0.0.1"))
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	_, err = DecodeIPv6("4)+k&C#VzJ4br>0wv%Y")
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	_, err = DecodeIPv6("=r54lj&NUUO~Hi%c2ym1")
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestGetWithError(t *testing.T) {
+	_, err := Get("base85")
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
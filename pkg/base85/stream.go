@@ -0,0 +1,206 @@
+package base85
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// bufSize is the number of encoded characters written to the underlying writer at once
+const bufSize = 4096
+
+// NewEncoder returns a stream encoder writing the encoding of the bytes written to it to w in
+// groups of four bytes; the encoder must be closed to flush the final partial group and delimiter
+func NewEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w}
+}
+
+type encoder struct {
+	enc     *Encoding
+	w       io.Writer
+	group   [groupBytes]byte
+	n       int
+	out     []byte
+	started bool
+	err     error
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	e.start()
+	n := 0
+	for len(p) > 0 {
+		take := copy(e.group[e.n:], p)
+		e.n += take
+		p = p[take:]
+		n += take
+		if e.n == groupBytes {
+			e.out = e.enc.appendGroup(e.out, e.group[:])
+			e.n = 0
+		}
+		// write in chunks so that memory use does not depend on the size of p
+		if len(e.out) >= bufSize || len(p) == 0 {
+			if e.err = e.flush(); e.err != nil {
+				return n, e.err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close flushes the final partial group and the closing delimiter; it does not close the
+// underlying writer
+func (e *encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	e.start()
+	if e.n > 0 {
+		if !e.enc.partial {
+			e.err = fmt.Errorf("number of bytes must be divisible by %d", groupBytes)
+			return e.err
+		}
+		e.out = e.enc.appendGroup(e.out, e.group[:e.n])
+		e.n = 0
+	}
+	if e.enc.delimit {
+		e.out = append(e.out, "~>"...)
+	}
+	e.err = e.flush()
+	return e.err
+}
+
+// start buffers the opening delimiter before the first group
+func (e *encoder) start() {
+	if !e.started && e.enc.delimit {
+		e.out = append(e.out, "<~"...)
+	}
+	e.started = true
+}
+
+// flush writes the encoded output to the underlying writer
+func (e *encoder) flush() error {
+	if len(e.out) == 0 {
+		return nil
+	}
+	_, err := e.w.Write(e.out)
+	e.out = e.out[:0]
+	return err
+}
+
+// appendGroup appends the encoding of a group of at most four bytes to dst; a partial group is
+// padded with zero bytes and encoded by one more character than its number of bytes
+func (e *Encoding) appendGroup(dst []byte, group []byte) []byte {
+	if e.zero && len(group) == groupBytes && group[0]|group[1]|group[2]|group[3] == 0 {
+		return append(dst, 'z')
+	}
+	padded := make([]byte, groupBytes)
+	copy(padded, group)
+	return append(dst, baseconv.EncodeBytes(padded, e.alpha)[:len(group)+1]...)
+}
+
+// NewDecoder returns a stream decoder reading the bytes encoded by the characters read from r in
+// groups of five characters, ignoring newline characters; the delimiters of a delimited encoding
+// are optional and all whitespace is ignored by an encoding with the z shortcut
+func NewDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: bufio.NewReaderSize(r, bufSize)}
+}
+
+type decoder struct {
+	enc     *Encoding
+	r       *bufio.Reader
+	group   []byte
+	out     []byte
+	started bool
+	ended   bool
+	err     error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) < len(p) && d.err == nil {
+		d.err = d.next()
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	if n > 0 {
+		return n, nil
+	}
+	return 0, d.err
+}
+
+// next consumes a character of the encoding, appending the bytes of a completed group to the output
+func (d *decoder) next() error {
+	c, err := d.r.ReadByte()
+	if err == io.EOF {
+		return d.finish()
+	}
+	if err != nil {
+		return err
+	}
+
+	if c == '\n' || c == '\r' || (d.enc.zero && strings.IndexByte(" \t\v\f", c) >= 0) {
+		return nil
+	}
+	if d.ended {
+		return fmt.Errorf("unexpected character [%c] after closing delimiter", c)
+	}
+	if d.enc.delimit {
+		if !d.started && c == '<' {
+			if next, _ := d.r.Peek(1); len(next) == 1 && next[0] == '~' {
+				d.r.ReadByte()
+				d.started = true
+				return nil
+			}
+		}
+		if c == '~' {
+			if next, _ := d.r.ReadByte(); next != '>' {
+				return errors.New("invalid closing delimiter")
+			}
+			d.ended = true
+			return nil
+		}
+	}
+	d.started = true
+
+	if d.enc.zero && c == 'z' && len(d.group) == 0 {
+		d.out = append(d.out, 0, 0, 0, 0)
+		return nil
+	}
+	d.group = append(d.group, c)
+	if len(d.group) < groupChars {
+		return nil
+	}
+	b, err := baseconv.DecodeBytes(string(d.group), groupBytes, d.enc.alpha)
+	if err != nil {
+		return err
+	}
+	d.out = append(d.out, b...)
+	d.group = d.group[:0]
+	return nil
+}
+
+// finish decodes the final partial group at the end of the input
+func (d *decoder) finish() error {
+	n := len(d.group)
+	if n == 0 {
+		return io.EOF
+	}
+	if !d.enc.partial || n == 1 {
+		return fmt.Errorf("invalid length [%d] of final group [%s]", n, d.group)
+	}
+	// pad with the largest digit so that the truncated bytes do not change the decoded bytes
+	group := string(d.group) + strings.Repeat(d.enc.alpha.String()[84:], groupChars-n)
+	b, err := baseconv.DecodeBytes(group, groupBytes, d.enc.alpha)
+	if err != nil {
+		return err
+	}
+	d.out = append(d.out, b[:n-1]...)
+	d.group = d.group[:0]
+	return io.EOF
+}
@@ -0,0 +1,116 @@
+package base85
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// encodeStream encodes src by writing it to a stream encoder in chunks of the specified size
+func encodeStream(t *testing.T, enc *Encoding, src []byte, chunk int) string {
+	sb := strings.Builder{}
+	w := NewEncoder(enc, &sb)
+	for len(src) > 0 {
+		n := chunk
+		if n > len(src) {
+			n = len(src)
+		}
+		if _, err := w.Write(src[:n]); err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		src = src[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	return sb.String()
+}
+
+func TestStreamMatchesEncodeToString(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, name := range Names() {
+		enc, err := Get(name)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		for _, size := range []int{0, 1, 3, 4, 5, 8, 100, 10000} {
+			if enc == Z85 && size%4 != 0 {
+				continue
+			}
+			src := make([]byte, size)
+			r.Read(src)
+			copy(src, []byte{0, 0, 0, 0})
+
+			expected, err := enc.EncodeToString(src)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			res := encodeStream(t, enc, src, 3)
+			if res != expected {
+				t.Fatalf("%s stream encoding %s not equal to expected %s", name, res, expected)
+			}
+
+			// read one byte at a time from a reader with a line break every 10 characters
+			wrapped := ""
+			for i := 0; i < len(res); i += 10 {
+				end := i + 10
+				if end > len(res) {
+					end = len(res)
+				}
+				wrapped += res[i:end] + "\n"
+			}
+			dec, err := io.ReadAll(NewDecoder(enc, iotest.OneByteReader(strings.NewReader(wrapped))))
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, src) {
+				t.Fatalf("%s stream decoding %x not equal to expected %x", name, dec, src)
+			}
+		}
+	}
+}
+
+func TestStreamEncodeWithError(t *testing.T) {
+	w := NewEncoder(Z85, io.Discard)
+	if _, err := w.Write([]byte{1, 2, 3}); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if err := w.Close(); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestStreamDecodeWithError(t *testing.T) {
+	type testCase struct {
+		enc *Encoding
+		str string
+	}
+
+	tests := map[string]testCase{
+		"ascii85 data after closing delimiter": {
+			enc: Ascii85,
+			str: "<~9jqo^~>9jqo^",
+		},
+		"ascii85 invalid closing delimiter": {
+			enc: Ascii85,
+			str: "<~9jqo^~",
+		},
+		"ascii85 z inside group": {
+			enc: Ascii85,
+			str: "<~9jz~>",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := io.ReadAll(NewDecoder(test.enc, strings.NewReader(test.str)))
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
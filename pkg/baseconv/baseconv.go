@@ -3,63 +3,123 @@ package baseconv
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"math/bits"
 )
 
 // roundoffTol is the tolerance for detecting roundoff error
 const roundoffTol = 1e-8
 
+// maxLargestDigits is the largest number of digits accepted by GetLargestBase10Big, far beyond the
+// width of any practical encoding, so that the value is computed quickly
+const maxLargestDigits = 1 << 16
+
 // FromBase10 converts a base 10 number to a slice representing the number in a specified base
 func FromBase10(num uint64, base uint64) ([]uint64, error) {
 	if err := validateBase(base); err != nil {
 		return nil, err
 	}
-	if num == 0 {
-		return []uint64{0}, nil
+	if shift, ok := powerOfTwo(base); ok {
+		return fromBase10Pow2(num, shift), nil
 	}
+	return fromBase10Generic(num, base), nil
+}
 
-	b := float64(base)
-	n := float64(num)
-	numDigits := getNumDigits(n, b, roundoffTol)
-	newBaseDigits := make([]uint64, numDigits)
+func fromBase10Generic(num uint64, base uint64) []uint64 {
+	if num == 0 {
+		return []uint64{0}
+	}
 
-	for i := 0; i < numDigits; i++ {
-		exponent := numDigits - i - 1
-		digitInBase10 := uint64(math.Pow(b, float64(exponent)))
-		newBaseDigits[i] = num / digitInBase10 // integer division
-		num = num % digitInBase10
+	// the floating point estimate of the number of digits is only a capacity hint, as float64
+	// cannot represent every uint64; the digits are computed exactly by repeated division
+	newBaseDigits := make([]uint64, 0, getNumDigits(float64(num), float64(base), roundoffTol))
+	for num > 0 {
+		newBaseDigits = append(newBaseDigits, num%base)
+		num /= base
 	}
 
-	return newBaseDigits, nil
+	for i, j := 0, len(newBaseDigits)-1; i < j; i, j = i+1, j-1 {
+		newBaseDigits[i], newBaseDigits[j] = newBaseDigits[j], newBaseDigits[i]
+	}
+	return newBaseDigits
 }
 
-// ToBase10 converts a number in a specified base represented by a slice into its base 10 value
+// ToBase10 converts a number in a specified base represented by a slice into its base 10 value,
+// returning an error if the value overflows a uint64
 func ToBase10(num []uint64, base uint64) (uint64, error) {
 	if err := validateBase(base); err != nil {
 		return 0, err
 	}
+	if shift, ok := powerOfTwo(base); ok {
+		return toBase10Pow2(num, shift)
+	}
+	return toBase10Generic(num, base)
+}
 
-	b := float64(base)
-	numDigits := len(num)
+func toBase10Generic(num []uint64, base uint64) (uint64, error) {
 	base10 := uint64(0)
-
-	for i, n := range num {
+	for _, n := range num {
 		if n >= base {
 			return 0, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
 		}
-		exponent := numDigits - i - 1
-		base10 += n * uint64(math.Pow(b, float64(exponent)))
+		hi, lo := bits.Mul64(base10, base)
+		sum, carry := bits.Add64(lo, n, 0)
+		if hi != 0 || carry != 0 {
+			return 0, fmt.Errorf("value of %v in base [%d] overflows uint64", num, base)
+		}
+		base10 = sum
 	}
-
 	return base10, nil
 }
 
 // GetLargestBase10 returns the largest base 10 number that can be represented
-// in the specified base with the specified number of digits
+// in the specified base with the specified number of digits, capped at math.MaxUint64
 func GetLargestBase10(base uint64, digits uint64) (uint64, error) {
 	if err := validateBase(base); err != nil {
 		return 0, err
 	}
-	return uint64(math.Pow(float64(base), float64(digits)) - 1), nil
+	capacity := uint64(1)
+	for i := uint64(0); i < digits; i++ {
+		hi, lo := bits.Mul64(capacity, base)
+		if hi != 0 {
+			return math.MaxUint64, nil
+		}
+		capacity = lo
+	}
+	return capacity - 1, nil
+}
+
+// GetLargestBase10Big returns the largest arbitrary precision base 10 number that can be
+// represented in the specified base with the specified number of digits
+func GetLargestBase10Big(base uint64, digits uint64) (*big.Int, error) {
+	if err := validateBase(base); err != nil {
+		return nil, err
+	}
+	if digits > maxLargestDigits {
+		return nil, fmt.Errorf("number of digits [%d] exceeds limit [%d]", digits, maxLargestDigits)
+	}
+	b := new(big.Int).SetUint64(base)
+	maxNum := b.Exp(b, new(big.Int).SetUint64(digits), nil)
+	return maxNum.Sub(maxNum, big.NewInt(1)), nil
+}
+
+// GetDigitsForCount returns the minimum number of digits required to represent
+// the specified count of distinct values in the specified base
+func GetDigitsForCount(count uint64, base uint64) (uint64, error) {
+	if err := validateBase(base); err != nil {
+		return 0, err
+	}
+	digits := uint64(1)
+	capacity := base
+	for capacity < count {
+		hi, lo := bits.Mul64(capacity, base)
+		digits++
+		if hi != 0 {
+			break
+		}
+		capacity = lo
+	}
+	return digits, nil
 }
 
 func validateBase(base uint64) error {
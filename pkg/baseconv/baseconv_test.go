@@ -53,6 +53,31 @@ func TestFromBase10(t *testing.T) {
 			base:     10,
 			expected: []uint64{1, 0, 0, 0},
 		},
+		"convert to binary with value one less than large power of 2": {
+			num:      1<<40 - 1,
+			base:     2,
+			expected: []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		"convert to base 10 from base 10 with value one less than large power of 10": {
+			num:      999_999_999_999_999,
+			base:     10,
+			expected: []uint64{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		},
+		"convert to base 10 with value not representable as float64": {
+			num:      1<<53 + 1,
+			base:     10,
+			expected: []uint64{9, 0, 0, 7, 1, 9, 9, 2, 5, 4, 7, 4, 0, 9, 9, 3},
+		},
+		"convert max uint64 to base 10": {
+			num:      math.MaxUint64,
+			base:     10,
+			expected: []uint64{1, 8, 4, 4, 6, 7, 4, 4, 0, 7, 3, 7, 0, 9, 5, 5, 1, 6, 1, 5},
+		},
+		"convert max uint64 to base 62": {
+			num:      math.MaxUint64,
+			base:     62,
+			expected: []uint64{21, 60, 42, 17, 36, 1, 6, 10, 17, 34, 15},
+		},
 	}
 
 	for name, test := range tests {
@@ -148,6 +173,16 @@ func TestToBase10(t *testing.T) {
 			base:     62,
 			expected: 62,
 		},
+		"convert max uint64 from base 62": {
+			num:      []uint64{21, 60, 42, 17, 36, 1, 6, 10, 17, 34, 15},
+			base:     62,
+			expected: math.MaxUint64,
+		},
+		"convert max uint64 from base 10 with leading zeros": {
+			num:      []uint64{0, 0, 1, 8, 4, 4, 6, 7, 4, 4, 0, 7, 3, 7, 0, 9, 5, 5, 1, 6, 1, 5},
+			base:     10,
+			expected: math.MaxUint64,
+		},
 		"convert from base 62 number with number larger than 62": {
 			num:      []uint64{61, 60, 14, 45, 17, 10, 24},
 			base:     62,
@@ -197,6 +232,14 @@ func TestToBase10WithError(t *testing.T) {
 			num:  []uint64{0},
 			base: 1,
 		},
+		"value overflowing uint64": {
+			num:  []uint64{21, 60, 42, 17, 36, 1, 6, 10, 17, 34, 16},
+			base: 62,
+		},
+		"value overflowing uint64 in power of two base": {
+			num:  []uint64{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			base: 16,
+		},
 	}
 
 	for name, test := range tests {
@@ -253,6 +296,21 @@ func TestGetLargestBase10(t *testing.T) {
 			digits:   0,
 			expected: 0,
 		},
+		"base=62 digits=7": {
+			base:     62,
+			digits:   7,
+			expected: 3_521_614_606_207,
+		},
+		"base=2 digits=64": {
+			base:     2,
+			digits:   64,
+			expected: math.MaxUint64,
+		},
+		"base=62 digits=11 exceeds uint64": {
+			base:     62,
+			digits:   11,
+			expected: math.MaxUint64,
+		},
 	}
 
 	for name, test := range tests {
@@ -269,6 +327,78 @@ func TestGetLargestBase10(t *testing.T) {
 	}
 }
 
+func TestGetLargestBase10Big(t *testing.T) {
+	type testCase struct {
+		base     uint64
+		digits   uint64
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"base=2 digits=0": {
+			base:     2,
+			digits:   0,
+			expected: "0",
+		},
+		"base=62 digits=7": {
+			base:     62,
+			digits:   7,
+			expected: "3521614606207",
+		},
+		"base=16 digits=16 equal to max uint64": {
+			base:     16,
+			digits:   16,
+			expected: "18446744073709551615",
+		},
+		"base=62 digits=12 exceeding max uint64": {
+			base:     62,
+			digits:   12,
+			expected: "3226266762397899821055",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := GetLargestBase10Big(test.base, test.digits)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res.String() != test.expected {
+				t.Errorf("result %s not equal to expected %s", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestGetLargestBase10BigWithError(t *testing.T) {
+	type testCase struct {
+		base   uint64
+		digits uint64
+	}
+
+	tests := map[string]testCase{
+		"base=1 digits=10": {
+			base:   1,
+			digits: 10,
+		},
+		"digits exceeding limit": {
+			base:   62,
+			digits: 1_000_000_000,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := GetLargestBase10Big(test.base, test.digits)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
 func TestGetLargestBase10WithError(t *testing.T) {
 	type testCase struct {
 		base   uint64
@@ -297,6 +427,93 @@ func TestGetLargestBase10WithError(t *testing.T) {
 	}
 }
 
+func TestGetDigitsForCount(t *testing.T) {
+	type testCase struct {
+		count    uint64
+		base     uint64
+		expected uint64
+	}
+
+	tests := map[string]testCase{
+		"count=0 base=2": {
+			count:    0,
+			base:     2,
+			expected: 1,
+		},
+		"count=2 base=2": {
+			count:    2,
+			base:     2,
+			expected: 1,
+		},
+		"count=3 base=2": {
+			count:    3,
+			base:     2,
+			expected: 2,
+		},
+		"count=1000 base=10": {
+			count:    1000,
+			base:     10,
+			expected: 3,
+		},
+		"count=1001 base=10": {
+			count:    1001,
+			base:     10,
+			expected: 4,
+		},
+		"count=10^12 base=62": {
+			count:    1_000_000_000_000,
+			base:     62,
+			expected: 7,
+		},
+		"count=max uint64 base=2": {
+			count:    math.MaxUint64,
+			base:     2,
+			expected: 64,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := GetDigitsForCount(test.count, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res != test.expected {
+				t.Errorf("result %d not equal to expected %d", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestGetDigitsForCountWithError(t *testing.T) {
+	type testCase struct {
+		count uint64
+		base  uint64
+	}
+
+	tests := map[string]testCase{
+		"base=0": {
+			count: 10,
+			base:  0,
+		},
+		"base=1": {
+			count: 10,
+			base:  1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := GetDigitsForCount(test.count, test.base)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
 func TestGetNumDigits(t *testing.T) {
 	type testCase struct {
 		num      float64
@@ -0,0 +1,224 @@
+package baseconv
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+// fromBigThreshold and toBigThreshold are the numbers of digits below which arbitrary precision
+// numbers are converted by repeated division or multiplication rather than by divide and conquer,
+// set from the crossover of BenchmarkFromBase10Big and BenchmarkToBase10Big
+const (
+	fromBigThreshold = 256
+	toBigThreshold   = 1024
+)
+
+// maxCachedBase is the largest base whose converter is cached, the size of the largest alphabet of
+// unique ASCII characters, and maxCachedExp is the largest exponent of a cached power of the base;
+// together they bound the memory held by the cache in a long running process
+const (
+	maxCachedBase = 128
+	maxCachedExp  = 1 << 16
+)
+
+// bigConverters caches a *bigConverter for each base up to maxCachedBase so that powers of the
+// base are computed once
+var bigConverters sync.Map
+
+// FromBase10Big converts an arbitrary precision base 10 number to a slice representing the number in a specified base
+func FromBase10Big(num *big.Int, base uint64) ([]uint64, error) {
+	if err := validateBase(base); err != nil {
+		return nil, err
+	}
+	if num.Sign() < 0 {
+		return nil, fmt.Errorf("cannot convert negative number [%s]", num)
+	}
+	if num.Sign() == 0 {
+		return []uint64{0}, nil
+	}
+
+	// an upper bound on the number of digits, whose excess becomes leading zeros
+	width := int(float64(num.BitLen())/math.Log2(float64(base))) + 2
+	newBaseDigits := make([]uint64, width)
+	c := getBigConverter(base)
+	c.fromBase10(new(big.Int).Set(num), newBaseDigits)
+
+	for len(newBaseDigits) > 1 && newBaseDigits[0] == 0 {
+		newBaseDigits = newBaseDigits[1:]
+	}
+	return newBaseDigits, nil
+}
+
+// ToBase10Big converts a number in a specified base represented by a slice into its arbitrary precision base 10 value
+func ToBase10Big(num []uint64, base uint64) (*big.Int, error) {
+	if err := validateBase(base); err != nil {
+		return nil, err
+	}
+	for _, n := range num {
+		if n >= base {
+			return nil, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
+		}
+	}
+	c := getBigConverter(base)
+	return c.toBase10(num), nil
+}
+
+// FromBase10Any converts an arbitrary precision base 10 number to a slice representing the number in
+// a specified base, using FromBase10 for numbers that fit in a uint64 and FromBase10Big otherwise
+func FromBase10Any(num *big.Int, base uint64) ([]uint64, error) {
+	if num.IsUint64() {
+		return FromBase10(num.Uint64(), base)
+	}
+	return FromBase10Big(num, base)
+}
+
+// ToBase10Any converts a number in a specified base represented by a slice into its arbitrary precision
+// base 10 value, using ToBase10 for numbers whose digits cannot exceed a uint64 and ToBase10Big otherwise
+func ToBase10Any(num []uint64, base uint64) (*big.Int, error) {
+	maxNum, err := GetLargestBase10(base, uint64(len(num)))
+	if err != nil {
+		return nil, err
+	}
+	if maxNum < math.MaxUint64 {
+		dec, err := ToBase10(num, base)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(dec), nil
+	}
+	return ToBase10Big(num, base)
+}
+
+// bigConverter converts arbitrary precision numbers by splitting them in halves at powers of the
+// base, so that the conversion takes the time of a few multiplications of the whole number rather
+// than time quadratic in its number of digits
+type bigConverter struct {
+	base uint64
+	// wordBase is the largest power of the base that fits in a uint64, and wordDigits is its exponent
+	wordBase   uint64
+	wordDigits int
+
+	// mu guards powers, which caches the powers of the base by exponent; the cached values are
+	// never modified
+	mu     sync.Mutex
+	powers map[int]*big.Int
+}
+
+// getBigConverter returns the cached converter for a base, creating it on first use; a converter
+// for a base larger than maxCachedBase is not cached
+func getBigConverter(base uint64) *bigConverter {
+	if base > maxCachedBase {
+		return newBigConverter(base)
+	}
+	if c, ok := bigConverters.Load(base); ok {
+		return c.(*bigConverter)
+	}
+	c, _ := bigConverters.LoadOrStore(base, newBigConverter(base))
+	return c.(*bigConverter)
+}
+
+func newBigConverter(base uint64) *bigConverter {
+	c := &bigConverter{base: base, wordBase: base, wordDigits: 1, powers: map[int]*big.Int{}}
+	for {
+		hi, lo := bits.Mul64(c.wordBase, base)
+		if hi != 0 {
+			break
+		}
+		c.wordBase = lo
+		c.wordDigits++
+	}
+	return c
+}
+
+// pow returns the base raised to the specified exponent, caching it if the exponent does not
+// exceed maxCachedExp
+func (c *bigConverter) pow(exp int) *big.Int {
+	if exp > maxCachedExp {
+		return new(big.Int).Exp(new(big.Int).SetUint64(c.base), big.NewInt(int64(exp)), nil)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.powers[exp]
+	if !ok {
+		p = new(big.Int).Exp(new(big.Int).SetUint64(c.base), big.NewInt(int64(exp)), nil)
+		c.powers[exp] = p
+	}
+	return p
+}
+
+// splitDigits returns the number of low digits at which a number of n > 1 digits is split, the
+// largest power of two less than n, so that the cached powers of the base are few across sizes
+func splitDigits(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+// fromBase10 writes the digits of num, which must fit in len(dst) digits, to dst padded with
+// leading zeros; num is overwritten
+func (c *bigConverter) fromBase10(num *big.Int, dst []uint64) {
+	if len(dst) < fromBigThreshold {
+		c.fromBase10Simple(num, dst)
+		return
+	}
+	lowDigits := splitDigits(len(dst))
+	low := new(big.Int)
+	num.QuoRem(num, c.pow(lowDigits), low)
+	c.fromBase10(num, dst[:len(dst)-lowDigits])
+	c.fromBase10(low, dst[len(dst)-lowDigits:])
+}
+
+// fromBase10Simple writes the digits of num to dst by repeated division by the largest power of
+// the base that fits in a word, computing the digits of each remainder with machine arithmetic
+func (c *bigConverter) fromBase10Simple(num *big.Int, dst []uint64) {
+	wordBase := new(big.Int).SetUint64(c.wordBase)
+	rem := new(big.Int)
+	i := len(dst)
+	for num.Sign() > 0 {
+		num.QuoRem(num, wordBase, rem)
+		r := rem.Uint64()
+		for j := 0; j < c.wordDigits && i > 0; j++ {
+			i--
+			dst[i] = r % c.base
+			r /= c.base
+		}
+	}
+	for i > 0 {
+		i--
+		dst[i] = 0
+	}
+}
+
+// toBase10 returns the value of digits that are all less than the base
+func (c *bigConverter) toBase10(num []uint64) *big.Int {
+	if len(num) < toBigThreshold {
+		return c.toBase10Simple(num)
+	}
+	lowDigits := splitDigits(len(num))
+	high := c.toBase10(num[:len(num)-lowDigits])
+	high.Mul(high, c.pow(lowDigits))
+	return high.Add(high, c.toBase10(num[len(num)-lowDigits:]))
+}
+
+// toBase10Simple returns the value of digits by repeated multiplication by the largest power of
+// the base that fits in a word, accumulating the digits of each word with machine arithmetic
+func (c *bigConverter) toBase10Simple(num []uint64) *big.Int {
+	base10 := new(big.Int)
+	word := new(big.Int)
+	for len(num) > 0 {
+		n := c.wordDigits
+		if n > len(num) {
+			n = len(num)
+		}
+		scale, acc := uint64(1), uint64(0)
+		for _, d := range num[:n] {
+			acc = acc*c.base + d
+			scale *= c.base
+		}
+		base10.Mul(base10, word.SetUint64(scale))
+		base10.Add(base10, word.SetUint64(acc))
+		num = num[n:]
+	}
+	return base10
+}
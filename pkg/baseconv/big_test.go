@@ -0,0 +1,386 @@
+package baseconv
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFromBase10Big(t *testing.T) {
+	type testCase struct {
+		num      string
+		base     uint64
+		expected []uint64
+	}
+
+	tests := map[string]testCase{
+		"convert zero": {
+			num:      "0",
+			base:     2,
+			expected: []uint64{0},
+		},
+		"convert to binary": {
+			num:      "5",
+			base:     2,
+			expected: []uint64{1, 0, 1},
+		},
+		"convert to base 62 with number larger than 62": {
+			num:      "3520000000000",
+			base:     62,
+			expected: []uint64{61, 60, 14, 45, 17, 10, 24},
+		},
+		"convert to base 16 with number equal to 2^64": {
+			num:      "18446744073709551616",
+			base:     16,
+			expected: []uint64{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"convert to base 1000 with number larger than max uint64": {
+			num:      "123456789012345678901234",
+			base:     1000,
+			expected: []uint64{123, 456, 789, 12, 345, 678, 901, 234},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			num, ok := new(big.Int).SetString(test.num, 10)
+			if !ok {
+				t.Fatalf("invalid test number %s", test.num)
+			}
+			res, err := FromBase10Big(num, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(res) != len(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+				return
+			}
+			for i := 0; i < len(res); i++ {
+				if res[i] != test.expected[i] {
+					t.Errorf("result %v not equal to expected %v", res, test.expected)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestFromBase10BigWithError(t *testing.T) {
+	type testCase struct {
+		num  int64
+		base uint64
+	}
+
+	tests := map[string]testCase{
+		"negative number": {
+			num:  -1,
+			base: 2,
+		},
+		"target base 0": {
+			num:  1,
+			base: 0,
+		},
+		"target base 1": {
+			num:  0,
+			base: 1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := FromBase10Big(big.NewInt(test.num), test.base)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestToBase10Big(t *testing.T) {
+	type testCase struct {
+		num      []uint64
+		base     uint64
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"convert single zero": {
+			num:      []uint64{0},
+			base:     2,
+			expected: "0",
+		},
+		"convert from binary with leading zeros": {
+			num:      []uint64{0, 0, 1, 1},
+			base:     2,
+			expected: "3",
+		},
+		"convert from base 62 number with number larger than 62": {
+			num:      []uint64{61, 60, 14, 45, 17, 10, 24},
+			base:     62,
+			expected: "3520000000000",
+		},
+		"convert from base 16 with number equal to 2^64": {
+			num:      []uint64{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			base:     16,
+			expected: "18446744073709551616",
+		},
+		"convert from base 1000 with number larger than max uint64": {
+			num:      []uint64{123, 456, 789, 12, 345, 678, 901, 234},
+			base:     1000,
+			expected: "123456789012345678901234",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := ToBase10Big(test.num, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res.String() != test.expected {
+				t.Errorf("result %s not equal to expected %s", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestToBase10BigWithError(t *testing.T) {
+	type testCase struct {
+		num  []uint64
+		base uint64
+	}
+
+	tests := map[string]testCase{
+		"convert number equal to target base": {
+			num:  []uint64{5},
+			base: 5,
+		},
+		"target base 1": {
+			num:  []uint64{0},
+			base: 1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := ToBase10Big(test.num, test.base)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestBase10Any(t *testing.T) {
+	type testCase struct {
+		num      string
+		base     uint64
+		expected []uint64
+	}
+
+	tests := map[string]testCase{
+		"number fitting in uint64": {
+			num:      "3520000000000",
+			base:     62,
+			expected: []uint64{61, 60, 14, 45, 17, 10, 24},
+		},
+		"max uint64": {
+			num:      "18446744073709551615",
+			base:     16,
+			expected: []uint64{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
+		},
+		"number larger than max uint64": {
+			num:      "123456789012345678901234",
+			base:     1000,
+			expected: []uint64{123, 456, 789, 12, 345, 678, 901, 234},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			num, ok := new(big.Int).SetString(test.num, 10)
+			if !ok {
+				t.Fatalf("invalid test number %s", test.num)
+			}
+			res, err := FromBase10Any(num, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if fmt.Sprint(res) != fmt.Sprint(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+			}
+			dec, err := ToBase10Any(test.expected, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if dec.Cmp(num) != 0 {
+				t.Errorf("result %s not equal to expected %s", dec, num)
+			}
+		})
+	}
+}
+
+func TestBigDivideAndConquer(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, base := range []uint64{2, 7, 10, 16, 36, 58, 62} {
+		for _, numDigits := range []int{fromBigThreshold - 1, fromBigThreshold, toBigThreshold - 1, toBigThreshold, 2*toBigThreshold + 1, 5000} {
+			// a random number of the specified number of digits with some runs of zero digits
+			expected := make([]uint64, numDigits)
+			for i := range expected {
+				if r.Intn(4) > 0 {
+					expected[i] = uint64(r.Int63n(int64(base)))
+				}
+			}
+			expected[0] = 1
+
+			num, err := ToBase10Big(expected, base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			text := num.Text(int(base))
+			if len(text) != numDigits {
+				t.Fatalf("length %d of %d digits in base %d not equal to expected", len(text), numDigits, base)
+			}
+			for i, c := range text {
+				if d := uint64(strings.IndexRune(bigTextDigits, c)); d != expected[i] {
+					t.Fatalf("digit %d of %d digits in base %d is %d not equal to expected %d", i, numDigits, base, d, expected[i])
+				}
+			}
+
+			res, err := FromBase10Big(num, base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(res) != len(expected) {
+				t.Fatalf("length %d of %d digits in base %d not equal to expected", len(res), numDigits, base)
+			}
+			for i := range res {
+				if res[i] != expected[i] {
+					t.Fatalf("digit %d of %d digits in base %d is %d not equal to expected %d", i, numDigits, base, res[i], expected[i])
+				}
+			}
+		}
+	}
+}
+
+func TestBigConcurrentUse(t *testing.T) {
+	num := benchmarkBigNum(5000)
+	expected, err := FromBase10Big(num, 62)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := FromBase10Big(num, 62)
+			if err != nil || fmt.Sprint(res) != fmt.Sprint(expected) {
+				t.Errorf("result not equal to expected, error: %v", err)
+				return
+			}
+			dec, err := ToBase10Big(res, 62)
+			if err != nil || dec.Cmp(num) != 0 {
+				t.Errorf("result %s not equal to expected %s, error: %v", dec, num, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBigConverterCache(t *testing.T) {
+	// a base larger than any alphabet is converted without being cached
+	base := uint64(maxCachedBase + 1)
+	num := new(big.Int).Lsh(big.NewInt(1), 20000)
+	enc, err := FromBase10Big(num, base)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if _, ok := bigConverters.Load(base); ok {
+		t.Errorf("converter for base %d is cached", base)
+	}
+	dec, err := ToBase10Big(enc, base)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if dec.Cmp(num) != 0 {
+		t.Errorf("result %s not equal to expected %s", dec, num)
+	}
+
+	// powers with exponents larger than maxCachedExp are computed without being cached
+	num = new(big.Int).Lsh(big.NewInt(1), 4*maxCachedExp)
+	enc, err = FromBase10Big(num, 2)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	dec, err = ToBase10Big(enc, 2)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if dec.Cmp(num) != 0 {
+		t.Error("result not equal to expected")
+	}
+	c := getBigConverter(2)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for exp := range c.powers {
+		if exp > maxCachedExp {
+			t.Errorf("power with exponent %d is cached", exp)
+		}
+	}
+}
+
+// bigTextDigits are the digits of big.Int.Text
+const bigTextDigits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func BenchmarkFromBase10Big(b *testing.B) {
+	for _, numDigits := range []int{100, 300, 1000, 3000, 10000, 100000} {
+		num := benchmarkBigNum(numDigits)
+		c := newBigConverter(62)
+		dst := make([]uint64, numDigits)
+		n := new(big.Int)
+		b.Run(fmt.Sprintf("%d digits/simple", numDigits), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.fromBase10Simple(n.Set(num), dst)
+			}
+		})
+		b.Run(fmt.Sprintf("%d digits/divide and conquer", numDigits), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = FromBase10Big(num, 62)
+			}
+		})
+	}
+}
+
+func BenchmarkToBase10Big(b *testing.B) {
+	for _, numDigits := range []int{100, 300, 1000, 3000, 10000, 100000} {
+		c := newBigConverter(62)
+		digits := make([]uint64, numDigits)
+		c.fromBase10(benchmarkBigNum(numDigits), digits)
+		b.Run(fmt.Sprintf("%d digits/simple", numDigits), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.toBase10Simple(digits)
+			}
+		})
+		b.Run(fmt.Sprintf("%d digits/divide and conquer", numDigits), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = ToBase10Big(digits, 62)
+			}
+		})
+	}
+}
+
+// benchmarkBigNum returns a random number of the specified number of base 62 digits
+func benchmarkBigNum(numDigits int) *big.Int {
+	limit := new(big.Int).Exp(big.NewInt(62), big.NewInt(int64(numDigits)), nil)
+	return new(big.Int).Rand(rand.New(rand.NewSource(1)), limit)
+}
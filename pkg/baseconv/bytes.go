@@ -0,0 +1,55 @@
+package baseconv
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// EncodeBytes converts a big-endian unsigned integer of a fixed number of bytes to its encoding
+// in the base of the size of an alphabet, padded to the number of digits of the largest integer
+// of that number of bytes
+func EncodeBytes(b []byte, alpha *alphabet.Alphabet) string {
+	num := new(big.Int).SetBytes(b)
+	// the base of a valid alphabet is at least 2, so the conversion cannot fail
+	enc, _ := FromBase10Big(num, alpha.Len())
+	str, _ := alpha.ToString(enc)
+	padded, _ := alpha.Pad(str, BytesWidth(len(b), alpha.Len()))
+	return padded
+}
+
+// DecodeBytes converts a fixed-width encoding in the base of the size of an alphabet to a
+// big-endian unsigned integer of the specified number of bytes
+func DecodeBytes(str string, size int, alpha *alphabet.Alphabet) ([]byte, error) {
+	width := BytesWidth(size, alpha.Len())
+	if len(str) != width {
+		return nil, fmt.Errorf("length of [%s] not equal to width [%d] of %d bytes", str, width, size)
+	}
+	numeric, err := alpha.FromString(str)
+	if err != nil {
+		return nil, err
+	}
+	num, err := ToBase10Big(numeric, alpha.Len())
+	if err != nil {
+		return nil, err
+	}
+	if num.BitLen() > 8*size {
+		return nil, fmt.Errorf("value of [%s] exceeds %d bytes", str, size)
+	}
+	return num.FillBytes(make([]byte, size)), nil
+}
+
+// BytesWidth returns the number of digits in the specified base of the largest integer of
+// the specified number of bytes
+func BytesWidth(size int, base uint64) int {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(8*size))
+	capacity := big.NewInt(1)
+	b := new(big.Int).SetUint64(base)
+	width := 0
+	for capacity.Cmp(limit) < 0 {
+		capacity.Mul(capacity, b)
+		width++
+	}
+	return width
+}
@@ -0,0 +1,105 @@
+package baseconv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func TestEncodeAndDecodeBytes(t *testing.T) {
+	type testCase struct {
+		b         []byte
+		alphaName string
+		expected  string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			b:         []byte{},
+			alphaName: "base62",
+			expected:  "",
+		},
+		"zero padded to width": {
+			b:         []byte{0, 0, 0},
+			alphaName: "base62",
+			expected:  "00000",
+		},
+		"leading zero byte": {
+			b:         []byte{0, 1, 2, 255},
+			alphaName: "base62",
+			expected:  "000hfp",
+		},
+		"crockford": {
+			b:         []byte{0xff, 0xff},
+			alphaName: "crockford",
+			expected:  "1ZZZ",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			alpha, err := alphabet.Get(test.alphaName)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			enc := EncodeBytes(test.b, alpha)
+			if enc != test.expected {
+				t.Errorf("encoding %s not equal to expected %s", enc, test.expected)
+			}
+			dec, err := DecodeBytes(enc, len(test.b), alpha)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, test.b) {
+				t.Errorf("decoding %x not equal to expected %x", dec, test.b)
+			}
+		})
+	}
+}
+
+func TestDecodeBytesWithError(t *testing.T) {
+	type testCase struct {
+		str  string
+		size int
+	}
+
+	tests := map[string]testCase{
+		"too short": {
+			str:  "ZZZ",
+			size: 2,
+		},
+		"too long": {
+			str:  "0ZZZ",
+			size: 1,
+		},
+		"exceeds size": {
+			str:  "h32",
+			size: 2,
+		},
+		"character not in alphabet": {
+			str:  "0-0",
+			size: 2,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeBytes(test.str, test.size, alphabet.Default)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestBytesWidth(t *testing.T) {
+	expected := map[uint64]int{2: 160, 16: 40, 32: 32, 62: 27}
+	for base, width := range expected {
+		if res := BytesWidth(20, base); res != width {
+			t.Errorf("width %d for base %d not equal to expected %d", res, base, width)
+		}
+	}
+}
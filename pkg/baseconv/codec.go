@@ -0,0 +1,168 @@
+package baseconv
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// Case is the policy for the case of the letters in encoded strings
+type Case int
+
+const (
+	// CaseSensitive encodes with the characters of the alphabet and decodes only those characters
+	CaseSensitive Case = iota
+	// CaseUpper encodes with upper case letters and decodes letters of either case
+	CaseUpper
+	// CaseLower encodes with lower case letters and decodes letters of either case
+	CaseLower
+)
+
+// Codec converts between integers and string representations with a fixed base, alphabet,
+// width, padding and case policy; a Codec is immutable and safe for concurrent use
+type Codec struct {
+	base  uint64
+	alpha *alphabet.Alphabet
+	width uint64
+	pad   bool
+	cases Case
+}
+
+// NewCodec creates a Codec for the specified base using the characters of an alphabet,
+// with no maximum width, no padding and case sensitivity
+func NewCodec(base uint64, alpha *alphabet.Alphabet) (*Codec, error) {
+	if err := validateBase(base); err != nil {
+		return nil, err
+	}
+	if base > alpha.Len() {
+		return nil, fmt.Errorf("base [%d] exceeds alphabet size [%d]", base, alpha.Len())
+	}
+	return &Codec{base: base, alpha: alpha}, nil
+}
+
+// WithWidth creates a new Codec identical to c but limiting encoded strings to the specified
+// number of characters, with zero meaning no limit
+func (c Codec) WithWidth(width uint64) *Codec {
+	c.width = width
+	return &c
+}
+
+// WithPadding creates a new Codec identical to c but padding encoded strings to exactly its width
+// with the zero character of the alphabet; padding has no effect on a Codec without a width
+func (c Codec) WithPadding(pad bool) *Codec {
+	c.pad = pad
+	return &c
+}
+
+// WithCase creates a new Codec identical to c but with the specified case policy; only the
+// first base characters of the alphabet are folded, and an error is returned if two of them
+// differ only in case and the policy is not CaseSensitive
+func (c Codec) WithCase(policy Case) (*Codec, error) {
+	chars := c.alpha.String()[:c.base]
+	switch policy {
+	case CaseSensitive:
+	case CaseUpper:
+		chars = strings.ToUpper(chars)
+	case CaseLower:
+		chars = strings.ToLower(chars)
+	default:
+		return nil, fmt.Errorf("invalid case policy [%d]", policy)
+	}
+	alpha, err := alphabet.New(chars)
+	if err != nil {
+		return nil, fmt.Errorf("alphabet cannot be case insensitive: %v", err)
+	}
+	c.alpha = alpha
+	c.cases = policy
+	return &c, nil
+}
+
+// Base returns the base of the Codec
+func (c *Codec) Base() uint64 {
+	return c.base
+}
+
+// Width returns the maximum number of characters of an encoded string, with zero meaning no limit
+func (c *Codec) Width() uint64 {
+	return c.width
+}
+
+// MaxValue returns the largest integer that can be encoded, capped at math.MaxUint64
+func (c *Codec) MaxValue() uint64 {
+	if c.width == 0 {
+		return math.MaxUint64
+	}
+	// the base was validated when creating the Codec
+	maxValue, _ := GetLargestBase10(c.base, c.width)
+	return maxValue
+}
+
+// EncodedLen returns the length of the string encoding an integer
+func (c *Codec) EncodedLen(num uint64) int {
+	if c.pad && c.width > 0 {
+		return int(c.width)
+	}
+	n := 1
+	for num >= c.base {
+		num /= c.base
+		n++
+	}
+	return n
+}
+
+// EncodeToString returns the string encoding an integer
+func (c *Codec) EncodeToString(num uint64) (string, error) {
+	enc, err := c.AppendEncode(nil, num)
+	if err != nil {
+		return "", err
+	}
+	return string(enc), nil
+}
+
+// AppendEncode appends the string encoding an integer to dst and returns the extended buffer
+func (c *Codec) AppendEncode(dst []byte, num uint64) ([]byte, error) {
+	if num > c.MaxValue() {
+		return dst, fmt.Errorf("cannot encode %d in base %d with %d digits", num, c.base, c.width)
+	}
+	numeric, err := FromBase10(num, c.base)
+	if err != nil {
+		return dst, err
+	}
+	str, err := c.alpha.ToString(numeric)
+	if err != nil {
+		return dst, err
+	}
+	if c.pad && c.width > 0 {
+		dst = append(dst, strings.Repeat(c.alpha.Zero(), int(c.width)-len(str))...)
+	}
+	return append(dst, str...), nil
+}
+
+// DecodeString returns the integer encoded by a string
+func (c *Codec) DecodeString(str string) (uint64, error) {
+	if c.width > 0 {
+		if c.pad && uint64(len(str)) != c.width {
+			return 0, fmt.Errorf("length of [%s] not equal to padded width [%d]", str, c.width)
+		}
+		if uint64(len(str)) > c.width {
+			return 0, fmt.Errorf("length of [%s] exceeds width [%d]", str, c.width)
+		}
+	}
+	if str == "" {
+		return 0, fmt.Errorf("cannot decode empty string")
+	}
+
+	switch c.cases {
+	case CaseUpper:
+		str = strings.ToUpper(str)
+	case CaseLower:
+		str = strings.ToLower(str)
+	}
+	numeric, err := c.alpha.FromString(str)
+	if err != nil {
+		return 0, err
+	}
+	return Decode[uint64](numeric, c.base)
+}
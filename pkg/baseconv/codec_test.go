@@ -0,0 +1,251 @@
+package baseconv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func mustCodec(t *testing.T, base uint64, name string) *Codec {
+	alpha, err := alphabet.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCodec(base, alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func mustCase(t *testing.T, c *Codec, policy Case) *Codec {
+	c, err := c.WithCase(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCodec(t *testing.T) {
+	type testCase struct {
+		codec    func(t *testing.T) *Codec
+		num      uint64
+		expected string
+		maxValue uint64
+	}
+
+	tests := map[string]testCase{
+		"codec without width": {
+			codec:    func(t *testing.T) *Codec { return mustCodec(t, 62, alphabet.DefaultName) },
+			num:      3_520_000_000_000,
+			expected: "ZYeJhao",
+			maxValue: math.MaxUint64,
+		},
+		"codec with width": {
+			codec:    func(t *testing.T) *Codec { return mustCodec(t, 62, alphabet.DefaultName).WithWidth(7) },
+			num:      1000,
+			expected: "g8",
+			maxValue: 3_521_614_606_207,
+		},
+		"codec with width and padding": {
+			codec: func(t *testing.T) *Codec {
+				return mustCodec(t, 62, alphabet.DefaultName).WithWidth(7).WithPadding(true)
+			},
+			num:      1000,
+			expected: "00000g8",
+			maxValue: 3_521_614_606_207,
+		},
+		"codec with padding and no width": {
+			codec:    func(t *testing.T) *Codec { return mustCodec(t, 62, alphabet.DefaultName).WithPadding(true) },
+			num:      1000,
+			expected: "g8",
+			maxValue: math.MaxUint64,
+		},
+		"codec with upper case": {
+			codec:    func(t *testing.T) *Codec { return mustCase(t, mustCodec(t, 36, "base36"), CaseUpper) },
+			num:      1_000_000,
+			expected: "LFLS",
+			maxValue: math.MaxUint64,
+		},
+		"codec with lower case": {
+			codec:    func(t *testing.T) *Codec { return mustCase(t, mustCodec(t, 32, "crockford"), CaseLower) },
+			num:      1_000_000,
+			expected: "ygj0",
+			maxValue: math.MaxUint64,
+		},
+		"codec with upper case and default alphabet": {
+			codec:    func(t *testing.T) *Codec { return mustCase(t, mustCodec(t, 36, alphabet.DefaultName), CaseUpper) },
+			num:      1_000_000,
+			expected: "LFLS",
+			maxValue: math.MaxUint64,
+		},
+		"codec with lower case and default alphabet": {
+			codec:    func(t *testing.T) *Codec { return mustCase(t, mustCodec(t, 36, alphabet.DefaultName), CaseLower) },
+			num:      1_000_000,
+			expected: "lfls",
+			maxValue: math.MaxUint64,
+		},
+		"codec with base smaller than alphabet": {
+			codec: func(t *testing.T) *Codec {
+				return mustCodec(t, 16, alphabet.DefaultName).WithWidth(2).WithPadding(true)
+			},
+			num:      255,
+			expected: "ff",
+			maxValue: 255,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			c := test.codec(t)
+
+			enc, err := c.EncodeToString(test.num)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if enc != test.expected {
+				t.Errorf("encoded %s not equal to expected %s", enc, test.expected)
+			}
+			if c.EncodedLen(test.num) != len(test.expected) {
+				t.Errorf("encoded length %d not equal to expected %d", c.EncodedLen(test.num), len(test.expected))
+			}
+
+			app, err := c.AppendEncode([]byte("id:"), test.num)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if string(app) != "id:"+test.expected {
+				t.Errorf("appended %s not equal to expected %s", app, "id:"+test.expected)
+			}
+
+			dec, err := c.DecodeString(test.expected)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if dec != test.num {
+				t.Errorf("decoded %d not equal to expected %d", dec, test.num)
+			}
+
+			if c.MaxValue() != test.maxValue {
+				t.Errorf("max value %d not equal to expected %d", c.MaxValue(), test.maxValue)
+			}
+		})
+	}
+}
+
+func TestCodecDecodeWithCaseFolding(t *testing.T) {
+	c := mustCase(t, mustCodec(t, 32, "crockford"), CaseUpper)
+	dec, err := c.DecodeString("yGj0")
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if dec != 1_000_000 {
+		t.Errorf("decoded %d not equal to expected %d", dec, 1_000_000)
+	}
+}
+
+func TestCodecWithError(t *testing.T) {
+	type testCase struct {
+		run func(c *Codec) error
+	}
+
+	base62 := func(t *testing.T) *Codec { return mustCodec(t, 62, alphabet.DefaultName) }
+
+	tests := map[string]testCase{
+		"encode value exceeding width": {
+			run: func(c *Codec) error {
+				_, err := c.WithWidth(2).EncodeToString(62 * 62)
+				return err
+			},
+		},
+		"decode string exceeding width": {
+			run: func(c *Codec) error {
+				_, err := c.WithWidth(2).DecodeString("100")
+				return err
+			},
+		},
+		"decode unpadded string": {
+			run: func(c *Codec) error {
+				_, err := c.WithWidth(3).WithPadding(true).DecodeString("1")
+				return err
+			},
+		},
+		"decode empty string": {
+			run: func(c *Codec) error {
+				_, err := c.DecodeString("")
+				return err
+			},
+		},
+		"decode character not in alphabet": {
+			run: func(c *Codec) error {
+				_, err := c.DecodeString("a@")
+				return err
+			},
+		},
+		"decode case sensitive string with wrong case": {
+			run: func(c *Codec) error {
+				_, err := mustCodec(t, 32, "crockford").DecodeString("y")
+				return err
+			},
+		},
+		"decode value overflowing uint64": {
+			run: func(c *Codec) error {
+				_, err := c.DecodeString("ZZZZZZZZZZZZ")
+				return err
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.run(base62(t))
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestNewCodecWithError(t *testing.T) {
+	if _, err := NewCodec(1, alphabet.Default); err == nil {
+		t.Error("expected non nil error for base less than 2")
+	}
+	if _, err := NewCodec(63, alphabet.Default); err == nil {
+		t.Error("expected non nil error for base exceeding alphabet size")
+	}
+}
+
+func TestCodecWithCaseWithError(t *testing.T) {
+	type testCase struct {
+		base   uint64
+		policy Case
+	}
+
+	tests := map[string]testCase{
+		"base using both cases of a letter": {
+			base:   62,
+			policy: CaseUpper,
+		},
+		"base using one more character than a single case": {
+			base:   37,
+			policy: CaseLower,
+		},
+		"invalid policy": {
+			base:   36,
+			policy: Case(3),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := mustCodec(t, test.base, alphabet.DefaultName).WithCase(test.policy)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package baseconv
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Signed is a constraint permitting any signed integer type
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint permitting any unsigned integer type
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint permitting any integer type
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Encode converts a non-negative integer of any integer type to a slice representing the number in a specified base
+func Encode[T Integer](num T, base uint64) ([]uint64, error) {
+	if num < 0 {
+		return nil, fmt.Errorf("cannot convert negative number [%d]", num)
+	}
+	return FromBase10(uint64(num), base)
+}
+
+// Decode converts a number in a specified base represented by a slice into its value as an integer
+// of type T, returning an error if the value overflows T
+func Decode[T Integer](num []uint64, base uint64) (T, error) {
+	if err := validateBase(base); err != nil {
+		return 0, err
+	}
+
+	base10 := uint64(0)
+	for _, n := range num {
+		if n >= base {
+			return 0, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
+		}
+		hi, lo := bits.Mul64(base10, base)
+		sum, carry := bits.Add64(lo, n, 0)
+		if hi != 0 || carry != 0 {
+			return 0, fmt.Errorf("value of %v in base [%d] overflows %T", num, base, T(0))
+		}
+		base10 = sum
+	}
+
+	// converting to T and back preserves the value only if it fits in T
+	val := T(base10)
+	if val < 0 || uint64(val) != base10 {
+		return 0, fmt.Errorf("value [%d] overflows %T", base10, val)
+	}
+	return val, nil
+}
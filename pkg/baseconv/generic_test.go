@@ -0,0 +1,162 @@
+package baseconv
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	type testCase struct {
+		encode   func() ([]uint64, error)
+		expected []uint64
+	}
+
+	tests := map[string]testCase{
+		"encode uint16": {
+			encode:   func() ([]uint64, error) { return Encode(uint16(65535), 16) },
+			expected: []uint64{15, 15, 15, 15},
+		},
+		"encode uint32": {
+			encode:   func() ([]uint64, error) { return Encode(uint32(62), 62) },
+			expected: []uint64{1, 0},
+		},
+		"encode int64": {
+			encode:   func() ([]uint64, error) { return Encode(int64(math.MaxInt64), 2) },
+			expected: []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		"encode int64 not representable as float64": {
+			encode:   func() ([]uint64, error) { return Encode(int64(math.MaxInt64), 62) },
+			expected: []uint64{10, 61, 21, 8, 49, 0, 34, 5, 8, 48, 7},
+		},
+		"encode int8 zero": {
+			encode:   func() ([]uint64, error) { return Encode(int8(0), 62) },
+			expected: []uint64{0},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := test.encode()
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(res) != len(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+				return
+			}
+			for i := 0; i < len(res); i++ {
+				if res[i] != test.expected[i] {
+					t.Errorf("result %v not equal to expected %v", res, test.expected)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeWithError(t *testing.T) {
+	type testCase struct {
+		encode func() ([]uint64, error)
+	}
+
+	tests := map[string]testCase{
+		"encode negative int64": {
+			encode: func() ([]uint64, error) { return Encode(int64(-1), 62) },
+		},
+		"encode with invalid base": {
+			encode: func() ([]uint64, error) { return Encode(uint32(10), 1) },
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := test.encode()
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	res16, err := Decode[uint16]([]uint64{15, 15, 15, 15}, 16)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if res16 != math.MaxUint16 {
+		t.Errorf("result %d not equal to expected %d", res16, math.MaxUint16)
+	}
+
+	res32, err := Decode[int32]([]uint64{1, 0}, 62)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if res32 != 62 {
+		t.Errorf("result %d not equal to expected %d", res32, 62)
+	}
+
+	res64, err := Decode[uint64]([]uint64{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15}, 16)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if res64 != math.MaxUint64 {
+		t.Errorf("result %d not equal to expected %d", res64, uint64(math.MaxUint64))
+	}
+}
+
+func TestDecodeWithError(t *testing.T) {
+	type testCase struct {
+		decode func() error
+	}
+
+	tests := map[string]testCase{
+		"decode value above max uint16": {
+			decode: func() error {
+				_, err := Decode[uint16]([]uint64{1, 0, 0, 0, 0}, 16)
+				return err
+			},
+		},
+		"decode value above max int8": {
+			decode: func() error {
+				_, err := Decode[int8]([]uint64{1, 0, 0, 0, 0, 0, 0, 0}, 2)
+				return err
+			},
+		},
+		"decode value above max int64": {
+			decode: func() error {
+				_, err := Decode[int64]([]uint64{8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 16)
+				return err
+			},
+		},
+		"decode value above max uint64": {
+			decode: func() error {
+				_, err := Decode[uint64]([]uint64{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 16)
+				return err
+			},
+		},
+		"decode with digit not in base": {
+			decode: func() error {
+				_, err := Decode[uint32]([]uint64{1, 62}, 62)
+				return err
+			},
+		},
+		"decode with invalid base": {
+			decode: func() error {
+				_, err := Decode[uint32]([]uint64{0}, 1)
+				return err
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.decode()
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package baseconv
+
+import (
+	"encoding/json"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// CodecProvider provides the Codec used to marshal an ID type; implementations are typically
+// empty structs returning a Codec created once at package level
+type CodecProvider interface {
+	Codec() *Codec
+}
+
+// Base62 is a CodecProvider of base 62 encoding with the default alphabet and no width
+type Base62 struct{}
+
+var base62Codec = &Codec{base: 62, alpha: alphabet.Default}
+
+// Codec returns the Codec of base 62 encoding with the default alphabet and no width
+func (Base62) Codec() *Codec {
+	return base62Codec
+}
+
+// ID is an integer identifier that is marshaled to and parsed from its encoding by the Codec
+// provided by C; it implements encoding.TextMarshaler, encoding.TextUnmarshaler, json.Marshaler,
+// json.Unmarshaler and flag.Value
+type ID[C CodecProvider] uint64
+
+func (id ID[C]) codec() *Codec {
+	var provider C
+	return provider.Codec()
+}
+
+// Uint64 returns the integer value of the ID
+func (id ID[C]) Uint64() uint64 {
+	return uint64(id)
+}
+
+// String returns the encoding of the ID, or the error message if the ID cannot be encoded
+func (id ID[C]) String() string {
+	str, err := id.codec().EncodeToString(uint64(id))
+	if err != nil {
+		return err.Error()
+	}
+	return str
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (id ID[C]) MarshalText() ([]byte, error) {
+	return id.codec().AppendEncode(nil, uint64(id))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (id *ID[C]) UnmarshalText(text []byte) error {
+	val, err := id.codec().DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*id = ID[C](val)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler by encoding the ID as a JSON string
+func (id ID[C]) MarshalJSON() ([]byte, error) {
+	text, err := id.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding the ID from a JSON string
+func (id *ID[C]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return id.UnmarshalText([]byte(str))
+}
+
+// Set implements flag.Value by decoding the ID from a flag value
+func (id *ID[C]) Set(str string) error {
+	return id.UnmarshalText([]byte(str))
+}
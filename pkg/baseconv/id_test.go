@@ -0,0 +1,119 @@
+package baseconv
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+var orderCodec = mustNewCodec(32, "crockford", CaseUpper).WithWidth(6).WithPadding(true)
+
+func mustNewCodec(base uint64, name string, policy Case) *Codec {
+	alpha, err := alphabet.Get(name)
+	if err != nil {
+		panic(err)
+	}
+	c, err := NewCodec(base, alpha)
+	if err != nil {
+		panic(err)
+	}
+	c, err = c.WithCase(policy)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+type orderCodecProvider struct{}
+
+func (orderCodecProvider) Codec() *Codec {
+	return orderCodec
+}
+
+type orderID = ID[orderCodecProvider]
+
+type resource struct {
+	User  ID[Base62] `json:"user"`
+	Order orderID    `json:"order"`
+}
+
+func TestIDMarshalJSON(t *testing.T) {
+	res, err := json.Marshal(resource{User: 3_520_000_000_000, Order: 1_000_000})
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	expected := `{"user":"ZYeJhao","order":"00YGJ0"}`
+	if string(res) != expected {
+		t.Errorf("result %s not equal to expected %s", res, expected)
+	}
+}
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	r := resource{}
+	err := json.Unmarshal([]byte(`{"user":"ZYeJhao","order":"00ygj0"}`), &r)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if r.User.Uint64() != 3_520_000_000_000 {
+		t.Errorf("user %d not equal to expected %d", r.User, 3_520_000_000_000)
+	}
+	if r.Order.Uint64() != 1_000_000 {
+		t.Errorf("order %d not equal to expected %d", r.Order, 1_000_000)
+	}
+}
+
+func TestIDUnmarshalJSONWithError(t *testing.T) {
+	type testCase struct {
+		data string
+	}
+
+	tests := map[string]testCase{
+		"number instead of string": {
+			data: `{"order":1000000}`,
+		},
+		"unpadded encoding": {
+			data: `{"order":"YGJ0"}`,
+		},
+		"character not in alphabet": {
+			data: `{"user":"a@"}`,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			r := resource{}
+			err := json.Unmarshal([]byte(test.data), &r)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestIDMarshalTextWithError(t *testing.T) {
+	id := orderID(1 << 30)
+	_, err := id.MarshalText()
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestIDFlag(t *testing.T) {
+	var id orderID
+	cmd := flag.NewFlagSet("test", flag.ContinueOnError)
+	cmd.Var(&id, "order", "order id")
+
+	err := cmd.Parse([]string{"-order", "00YGJ0"})
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if id.Uint64() != 1_000_000 {
+		t.Errorf("order %d not equal to expected %d", id, 1_000_000)
+	}
+	if id.String() != "00YGJ0" {
+		t.Errorf("order %s not equal to expected %s", id, "00YGJ0")
+	}
+}
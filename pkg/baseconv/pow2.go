@@ -0,0 +1,112 @@
+package baseconv
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// powerOfTwo returns the base 2 logarithm of a base and whether the base is a power of two
+func powerOfTwo(base uint64) (uint, bool) {
+	if base&(base-1) != 0 {
+		return 0, false
+	}
+	return uint(bits.TrailingZeros64(base)), true
+}
+
+// fromBase10Pow2 converts a number to base 2^shift by extracting groups of shift bits
+func fromBase10Pow2(num uint64, shift uint) []uint64 {
+	numDigits := (bits.Len64(num) + int(shift) - 1) / int(shift)
+	if numDigits == 0 {
+		return []uint64{0}
+	}
+	mask := uint64(1)<<shift - 1
+	digits := make([]uint64, numDigits)
+	for i := numDigits - 1; i >= 0; i-- {
+		digits[i] = num & mask
+		num >>= shift
+	}
+	return digits
+}
+
+// toBase10Pow2 converts a number in base 2^shift to base 10 by concatenating groups of shift bits
+func toBase10Pow2(num []uint64, shift uint) (uint64, error) {
+	base := uint64(1) << shift
+	base10 := uint64(0)
+	for _, n := range num {
+		if n >= base {
+			return 0, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
+		}
+		if base10>>(64-shift) != 0 {
+			return 0, fmt.Errorf("value of %v in base [%d] overflows uint64", num, base)
+		}
+		base10 = base10<<shift | n
+	}
+	return base10, nil
+}
+
+// FromBytes converts bit-packed bytes to a slice of digits in a power-of-two base of at most 256,
+// each digit holding the next group of bits of the bytes from most to least significant, with
+// a final partial group padded with zero bits
+func FromBytes(b []byte, base uint64) ([]uint64, error) {
+	shift, err := bitsPerDigit(base)
+	if err != nil {
+		return nil, err
+	}
+	mask := base - 1
+	digits := make([]uint64, 0, (8*len(b)+int(shift)-1)/int(shift))
+	acc, nbits := uint64(0), uint(0)
+	for _, c := range b {
+		acc = acc<<8 | uint64(c)
+		nbits += 8
+		for nbits >= shift {
+			nbits -= shift
+			digits = append(digits, acc>>nbits&mask)
+		}
+	}
+	if nbits > 0 {
+		digits = append(digits, acc<<(shift-nbits)&mask)
+	}
+	return digits, nil
+}
+
+// ToBytes converts a slice of digits in a power-of-two base of at most 256 to the bit-packed bytes
+// from which FromBytes produces them, returning an error for any other slice of digits
+func ToBytes(num []uint64, base uint64) ([]byte, error) {
+	shift, err := bitsPerDigit(base)
+	if err != nil {
+		return nil, err
+	}
+	numBytes := len(num) * int(shift) / 8
+	if (8*numBytes+int(shift)-1)/int(shift) != len(num) {
+		return nil, fmt.Errorf("number of digits [%d] in base [%d] does not encode a whole number of bytes", len(num), base)
+	}
+	b := make([]byte, 0, numBytes)
+	acc, nbits := uint64(0), uint(0)
+	for _, n := range num {
+		if n >= base {
+			return nil, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
+		}
+		acc = acc<<shift | n
+		nbits += shift
+		if nbits >= 8 {
+			nbits -= 8
+			b = append(b, byte(acc>>nbits))
+		}
+	}
+	if acc&(uint64(1)<<nbits-1) != 0 {
+		return nil, fmt.Errorf("nonzero padding bits in final digit")
+	}
+	return b, nil
+}
+
+// bitsPerDigit returns the number of bits of a digit in a power-of-two base of at most 256
+func bitsPerDigit(base uint64) (uint, error) {
+	if err := validateBase(base); err != nil {
+		return 0, err
+	}
+	shift, ok := powerOfTwo(base)
+	if !ok || shift > 8 {
+		return 0, fmt.Errorf("base [%d] must be a power of two no greater than 256", base)
+	}
+	return shift, nil
+}
@@ -0,0 +1,230 @@
+package baseconv
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+var pow2Bases = []uint64{2, 4, 8, 16, 32, 64}
+
+func TestPowerOfTwoMatchesGeneric(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	nums := []uint64{0, 1, 2, 63, 64, 65, 1<<32 - 1, 1 << 32, 1<<53 - 1, 1<<53 + 1, math.MaxUint64 - 1, math.MaxUint64}
+	for i := 0; i < 1000; i++ {
+		nums = append(nums, r.Uint64()>>(r.Intn(64)))
+	}
+
+	for _, base := range append(pow2Bases, 256, 1<<20) {
+		shift, ok := powerOfTwo(base)
+		if !ok {
+			t.Fatalf("base %d not detected as power of two", base)
+		}
+		for _, num := range nums {
+			res := fromBase10Pow2(num, shift)
+			expected, err := FromBase10Big(new(big.Int).SetUint64(num), base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if fmt.Sprint(res) != fmt.Sprint(expected) {
+				t.Fatalf("result %v for %d in base %d not equal to expected %v", res, num, base, expected)
+			}
+			dec, err := toBase10Pow2(res, shift)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if dec != num {
+				t.Fatalf("result %d in base %d not equal to expected %d", dec, base, num)
+			}
+
+			if generic := fromBase10Generic(num, base); fmt.Sprint(res) != fmt.Sprint(generic) {
+				t.Fatalf("result %v for %d in base %d not equal to generic %v", res, num, base, generic)
+			}
+			if generic, err := toBase10Generic(res, base); err != nil || dec != generic {
+				t.Fatalf("result %d in base %d not equal to generic %d", dec, base, generic)
+			}
+		}
+	}
+}
+
+func TestPowerOfTwo(t *testing.T) {
+	for _, base := range []uint64{3, 6, 10, 62, 1<<63 + 1} {
+		if _, ok := powerOfTwo(base); ok {
+			t.Errorf("base %d detected as power of two", base)
+		}
+	}
+}
+
+func TestToBase10Pow2WithError(t *testing.T) {
+	_, err := ToBase10([]uint64{1, 16}, 16)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestFromBytesAndToBytes(t *testing.T) {
+	type testCase struct {
+		b        []byte
+		base     uint64
+		expected []uint64
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			b:        []byte{},
+			base:     16,
+			expected: []uint64{},
+		},
+		"hexadecimal": {
+			b:        []byte{0x0f, 0xa0},
+			base:     16,
+			expected: []uint64{0, 15, 10, 0},
+		},
+		"binary": {
+			b:        []byte{0x81},
+			base:     2,
+			expected: []uint64{1, 0, 0, 0, 0, 0, 0, 1},
+		},
+		"base 32 with padding bits": {
+			b:        []byte{0x01},
+			base:     32,
+			expected: []uint64{0, 4},
+		},
+		"base 32 block": {
+			b:        []byte{0xff, 0x00, 0xff, 0x00, 0xff},
+			base:     32,
+			expected: []uint64{31, 28, 0, 15, 30, 0, 7, 31},
+		},
+		"base 64 with padding bits": {
+			b:        []byte{0xff, 0xff},
+			base:     64,
+			expected: []uint64{63, 63, 60},
+		},
+		"base 256": {
+			b:        []byte{0, 1, 255},
+			base:     256,
+			expected: []uint64{0, 1, 255},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := FromBytes(test.b, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if fmt.Sprint(res) != fmt.Sprint(test.expected) {
+				t.Errorf("result %v not equal to expected %v", res, test.expected)
+			}
+			b, err := ToBytes(res, test.base)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(b, test.b) {
+				t.Errorf("result %x not equal to expected %x", b, test.b)
+			}
+		})
+	}
+}
+
+func TestToBytesWithError(t *testing.T) {
+	type testCase struct {
+		num  []uint64
+		base uint64
+	}
+
+	tests := map[string]testCase{
+		"not power of two": {
+			num:  []uint64{1, 2},
+			base: 10,
+		},
+		"base exceeds 256": {
+			num:  []uint64{1, 2},
+			base: 512,
+		},
+		"partial byte": {
+			num:  []uint64{1},
+			base: 16,
+		},
+		"digit exceeds base": {
+			num:  []uint64{1, 16},
+			base: 16,
+		},
+		"nonzero padding bits": {
+			num:  []uint64{0, 1},
+			base: 32,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := ToBytes(test.num, test.base)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestFromBytesWithError(t *testing.T) {
+	for _, base := range []uint64{0, 1, 10, 512} {
+		_, err := FromBytes([]byte{1}, base)
+		if err == nil {
+			t.Fatalf("expected non nil error for base %d", base)
+		}
+	}
+}
+
+func BenchmarkFromBase10(b *testing.B) {
+	num := uint64(1<<53 - 12345)
+	for _, base := range pow2Bases {
+		shift, _ := powerOfTwo(base)
+		b.Run(fmt.Sprintf("base %d/pow2", base), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fromBase10Pow2(num, shift)
+			}
+		})
+		b.Run(fmt.Sprintf("base %d/generic", base), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fromBase10Generic(num, base)
+			}
+		})
+	}
+}
+
+func BenchmarkToBase10(b *testing.B) {
+	num := uint64(1<<53 - 12345)
+	for _, base := range pow2Bases {
+		shift, _ := powerOfTwo(base)
+		digits := fromBase10Pow2(num, shift)
+		b.Run(fmt.Sprintf("base %d/pow2", base), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = toBase10Pow2(digits, shift)
+			}
+		})
+		b.Run(fmt.Sprintf("base %d/generic", base), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = toBase10Generic(digits, base)
+			}
+		})
+	}
+}
+
+func BenchmarkFromBytes(b *testing.B) {
+	src := make([]byte, 1024)
+	rand.New(rand.NewSource(1)).Read(src)
+	for _, base := range pow2Bases {
+		base := base
+		b.Run(fmt.Sprintf("base %d", base), func(b *testing.B) {
+			b.SetBytes(int64(len(src)))
+			for i := 0; i < b.N; i++ {
+				_, _ = FromBytes(src, base)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package baseconv
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// Sortable converts between integers and string representations whose byte order matches the
+// order of the integers, so that a < b exactly when the encoding of a sorts before that of b;
+// a Sortable is immutable and safe for concurrent use
+type Sortable struct {
+	digits *Codec
+	// prefix encodes the number of digits less one of a variable-width encoding, and is nil
+	// for a fixed-width encoding
+	prefix *Codec
+}
+
+// NewSortable creates a Sortable for the specified base using an alphabet in ASCII order that
+// encodes integers with a variable number of digits preceded by a fixed-width prefix giving the
+// number of digits, so that shorter encodings of smaller integers sort first
+func NewSortable(base uint64, alpha *alphabet.Alphabet) (*Sortable, error) {
+	digits, err := newSortableCodec(base, alpha)
+	if err != nil {
+		return nil, err
+	}
+	maxDigits := uint64(digits.EncodedLen(math.MaxUint64))
+	prefix := digits.WithWidth(uint64(digits.EncodedLen(maxDigits - 1))).WithPadding(true)
+	return &Sortable{digits: digits, prefix: prefix}, nil
+}
+
+// NewFixedSortable creates a Sortable for the specified base using an alphabet in ASCII order
+// that pads encodings to exactly the specified number of digits
+func NewFixedSortable(base uint64, alpha *alphabet.Alphabet, width uint64) (*Sortable, error) {
+	if width == 0 {
+		return nil, fmt.Errorf("width of fixed-width encoding must be positive")
+	}
+	digits, err := newSortableCodec(base, alpha)
+	if err != nil {
+		return nil, err
+	}
+	return &Sortable{digits: digits.WithWidth(width).WithPadding(true)}, nil
+}
+
+func newSortableCodec(base uint64, alpha *alphabet.Alphabet) (*Codec, error) {
+	if !alpha.IsSorted() {
+		return nil, fmt.Errorf("alphabet [%s] is not in ASCII order", alpha)
+	}
+	return NewCodec(base, alpha)
+}
+
+// MaxValue returns the largest integer that can be encoded, capped at math.MaxUint64
+func (s *Sortable) MaxValue() uint64 {
+	return s.digits.MaxValue()
+}
+
+// EncodeToString returns the string encoding an integer
+func (s *Sortable) EncodeToString(num uint64) (string, error) {
+	if s.prefix == nil {
+		return s.digits.EncodeToString(num)
+	}
+	dst, err := s.prefix.AppendEncode(nil, uint64(s.digits.EncodedLen(num)-1))
+	if err != nil {
+		return "", err
+	}
+	dst, err = s.digits.AppendEncode(dst, num)
+	if err != nil {
+		return "", err
+	}
+	return string(dst), nil
+}
+
+// DecodeString returns the integer encoded by a string, rejecting encodings that differ from
+// the encoding of their integer so that the order of valid encodings is unambiguous
+func (s *Sortable) DecodeString(str string) (uint64, error) {
+	if s.prefix == nil {
+		return s.digits.DecodeString(str)
+	}
+
+	prefixWidth := int(s.prefix.Width())
+	if len(str) <= prefixWidth {
+		return 0, fmt.Errorf("length of [%s] does not exceed prefix width [%d]", str, prefixWidth)
+	}
+	numDigits, err := s.prefix.DecodeString(str[:prefixWidth])
+	if err != nil {
+		return 0, err
+	}
+	str = str[prefixWidth:]
+	if uint64(len(str)) != numDigits+1 {
+		return 0, fmt.Errorf("length of [%s] not equal to length [%d] given by prefix", str, numDigits+1)
+	}
+	num, err := s.digits.DecodeString(str)
+	if err != nil {
+		return 0, err
+	}
+	if s.digits.EncodedLen(num) != len(str) {
+		return 0, fmt.Errorf("[%s] has leading zeros", str)
+	}
+	return num, nil
+}
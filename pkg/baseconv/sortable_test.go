@@ -0,0 +1,253 @@
+package baseconv
+
+import (
+	"math"
+	"testing"
+	"testing/quick"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func mustSortable(t *testing.T, base uint64, name string, width uint64) *Sortable {
+	t.Helper()
+	alpha, err := alphabet.Get(name)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if width > 0 {
+		s, err := NewFixedSortable(base, alpha, width)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		return s
+	}
+	s, err := NewSortable(base, alpha)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	return s
+}
+
+func TestSortableEncodeToString(t *testing.T) {
+	type testCase struct {
+		base     uint64
+		alpha    string
+		width    uint64
+		num      uint64
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"length prefix zero": {
+			base:     62,
+			alpha:    "ascii62",
+			num:      0,
+			expected: "00",
+		},
+		"length prefix one digit": {
+			base:     62,
+			alpha:    "ascii62",
+			num:      61,
+			expected: "0z",
+		},
+		"length prefix two digits": {
+			base:     62,
+			alpha:    "ascii62",
+			num:      62,
+			expected: "110",
+		},
+		"length prefix max uint64": {
+			base:     62,
+			alpha:    "ascii62",
+			num:      math.MaxUint64,
+			expected: "ALygHa16AHYF",
+		},
+		"binary length prefix": {
+			base:     2,
+			alpha:    "base36",
+			num:      5,
+			expected: "000010101",
+		},
+		"fixed width": {
+			base:     62,
+			alpha:    "ascii62",
+			width:    4,
+			num:      62,
+			expected: "0010",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			s := mustSortable(t, test.base, test.alpha, test.width)
+			enc, err := s.EncodeToString(test.num)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if enc != test.expected {
+				t.Errorf("encoding %s not equal to expected %s", enc, test.expected)
+			}
+			dec, err := s.DecodeString(enc)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if dec != test.num {
+				t.Errorf("decoding %d not equal to expected %d", dec, test.num)
+			}
+		})
+	}
+}
+
+func TestSortableDecodeStringWithError(t *testing.T) {
+	type testCase struct {
+		width uint64
+		str   string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			str: "",
+		},
+		"prefix only": {
+			str: "1",
+		},
+		"too few digits for prefix": {
+			str: "20z",
+		},
+		"too many digits for prefix": {
+			str: "10z0",
+		},
+		"leading zero": {
+			str: "10z",
+		},
+		"character not in alphabet": {
+			str: "1-0",
+		},
+		"prefix exceeds max digits": {
+			str: "B100000000000",
+		},
+		"fixed width too short": {
+			width: 4,
+			str:   "010",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			s := mustSortable(t, 62, "ascii62", test.width)
+			_, err := s.DecodeString(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestNewSortableWithError(t *testing.T) {
+	_, err := NewSortable(62, alphabet.Default)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	_, err = NewFixedSortable(62, alphabet.Default, 11)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	ascii62, _ := alphabet.Get("ascii62")
+	_, err = NewFixedSortable(62, ascii62, 0)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	_, err = NewSortable(63, ascii62)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestSortableProperties(t *testing.T) {
+	type testCase struct {
+		base  uint64
+		alpha string
+		width uint64
+	}
+
+	tests := map[string]testCase{
+		"ascii62 length prefix": {
+			base:  62,
+			alpha: "ascii62",
+		},
+		"base36 length prefix": {
+			base:  36,
+			alpha: "base36",
+		},
+		"crockford length prefix": {
+			base:  32,
+			alpha: "crockford",
+		},
+		"binary length prefix": {
+			base:  2,
+			alpha: "ascii62",
+		},
+		"base58 fixed width": {
+			base:  58,
+			alpha: "base58",
+			width: 11,
+		},
+		"ascii62 fixed width": {
+			base:  62,
+			alpha: "ascii62",
+			width: 11,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			s := mustSortable(t, test.base, test.alpha, test.width)
+
+			// shifting spreads the values over all magnitudes and thus all encoded lengths
+			encode := func(num uint64, shift uint8) (uint64, string) {
+				num >>= shift % 64
+				enc, err := s.EncodeToString(num)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				return num, enc
+			}
+
+			roundTrip := func(num uint64, shift uint8) bool {
+				num, enc := encode(num, shift)
+				dec, err := s.DecodeString(enc)
+				return err == nil && dec == num
+			}
+			if err := quick.Check(roundTrip, nil); err != nil {
+				t.Error(err)
+			}
+
+			orderPreserving := func(a, b uint64, shiftA, shiftB uint8) bool {
+				a, encA := encode(a, shiftA)
+				b, encB := encode(b, shiftB)
+				return (a < b) == (encA < encB) && (a == b) == (encA == encB)
+			}
+			if err := quick.Check(orderPreserving, nil); err != nil {
+				t.Error(err)
+			}
+
+			// the encoded length changes at powers of the base, which random values rarely hit
+			prev, prevNum := "", uint64(0)
+			for power := uint64(1); power <= math.MaxUint64/test.base; power *= test.base {
+				for _, num := range []uint64{power - 1, power, power + 1} {
+					if (prev != "" && num <= prevNum) || num > s.MaxValue() {
+						continue
+					}
+					_, enc := encode(num, 0)
+					if enc <= prev {
+						t.Errorf("encoding %s of %d does not sort after previous %s", enc, num, prev)
+					}
+					prev, prevNum = enc, num
+				}
+			}
+		})
+	}
+}
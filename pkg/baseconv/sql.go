@@ -0,0 +1,41 @@
+package baseconv
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Scan implements sql.Scanner by reading the ID from an integer column, accepting
+// int64 values and []byte or string values holding base 10 integers
+func (id *ID[C]) Scan(src interface{}) error {
+	var val uint64
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("cannot scan negative value [%d] into ID", v)
+		}
+		val = uint64(v)
+	case []byte:
+		return id.Scan(string(v))
+	case string:
+		var err error
+		val, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("cannot scan [%s] into ID: %v", v, err)
+		}
+	default:
+		return fmt.Errorf("cannot scan value of type %T into ID", src)
+	}
+	*id = ID[C](val)
+	return nil
+}
+
+// Value implements driver.Valuer by writing the ID to an integer column as an int64
+func (id ID[C]) Value() (driver.Value, error) {
+	if uint64(id) > math.MaxInt64 {
+		return nil, fmt.Errorf("ID [%d] overflows int64", uint64(id))
+	}
+	return int64(id), nil
+}
@@ -0,0 +1,236 @@
+package baseconv
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// fakeDriver is a database/sql driver storing the single column of an integer table in memory;
+// a query selects the stored values as the driver value type named by the query
+type fakeDriver struct {
+	mu     sync.Mutex
+	values []int64
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("baseconvfake", testDriver)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return strings.Count(s.query, "?")
+}
+
+// Exec inserts a value, which must be an int64 as for a BIGINT column
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	v, ok := args[0].(int64)
+	if !ok {
+		return nil, fmt.Errorf("value of type %T not supported by BIGINT column", args[0])
+	}
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.values = append(s.d.values, v)
+	return driver.RowsAffected(1), nil
+}
+
+// Query selects the stored values converted to the driver value type named by the query
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	values := make([]driver.Value, len(s.d.values))
+	for i, v := range s.d.values {
+		switch s.query {
+		case "SELECT int64":
+			values[i] = v
+		case "SELECT bytes":
+			values[i] = []byte(strconv.FormatInt(v, 10))
+		case "SELECT string":
+			values[i] = strconv.FormatInt(v, 10)
+		default:
+			return nil, fmt.Errorf("unsupported query [%s]", s.query)
+		}
+	}
+	return &fakeRows{values: values}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	i      int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.i]
+	r.i++
+	return nil
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	testDriver.mu.Lock()
+	testDriver.values = nil
+	testDriver.mu.Unlock()
+
+	db, err := sql.Open("baseconvfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestIDScanAndValue(t *testing.T) {
+	nums := []uint64{0, 1000, 3_520_000_000_000, math.MaxInt64}
+
+	for _, query := range []string{"SELECT int64", "SELECT bytes", "SELECT string"} {
+		query := query
+		t.Run(query, func(t *testing.T) {
+			db := openTestDB(t)
+			for _, num := range nums {
+				if _, err := db.Exec("INSERT ?", ID[Base62](num)); err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+			}
+
+			rows, err := db.Query(query)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			defer rows.Close()
+
+			i := 0
+			for ; rows.Next(); i++ {
+				var id ID[Base62]
+				if err := rows.Scan(&id); err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+
+				// the slug exposed by the ID round trips through FromBase10 and ToBase10
+				numeric, err := alphabet.FromString(id.String())
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				dec, err := ToBase10(numeric, 62)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				enc, err := FromBase10(nums[i], 62)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				expected, err := alphabet.ToString(enc)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+
+				if id.Uint64() != nums[i] {
+					t.Errorf("scanned %d not equal to expected %d", id.Uint64(), nums[i])
+				}
+				if id.String() != expected {
+					t.Errorf("slug %s not equal to expected %s", id.String(), expected)
+				}
+				if dec != nums[i] {
+					t.Errorf("decoded slug %d not equal to expected %d", dec, nums[i])
+				}
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if i != len(nums) {
+				t.Errorf("scanned %d rows not equal to expected %d", i, len(nums))
+			}
+		})
+	}
+}
+
+func TestIDScanWithError(t *testing.T) {
+	type testCase struct {
+		src interface{}
+	}
+
+	tests := map[string]testCase{
+		"negative int64": {
+			src: int64(-1),
+		},
+		"bytes not holding an integer": {
+			src: []byte("g8"),
+		},
+		"string not holding an integer": {
+			src: "g8",
+		},
+		"unsupported type": {
+			src: float64(1000),
+		},
+		"nil": {
+			src: nil,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			var id ID[Base62]
+			err := id.Scan(test.src)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestIDValueWithError(t *testing.T) {
+	db := openTestDB(t)
+	_, err := db.Exec("INSERT ?", ID[Base62](math.MaxUint64))
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
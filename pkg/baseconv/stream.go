@@ -0,0 +1,266 @@
+package baseconv
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// StreamBlockSize is the number of bytes of the blocks encoded independently in a base that is
+// not a power of two
+const StreamBlockSize = 32
+
+// streamBufSize is the number of bytes read from the underlying reader of a decoder at a time
+const streamBufSize = 4096
+
+// StreamEncoding describes the encoding of a stream of bytes in a base using the characters of
+// an alphabet, in the manner of encoding/base32.Encoding.
+//
+// In a base 2^k, the bits of the stream are packed k to a character, most significant first,
+// with a final partial character padded with zero bits; in base 32 this is unpadded base32.
+//
+// In any other base, the stream is split into blocks of StreamBlockSize bytes, each encoded as
+// a big-endian integer padded to the number of characters of the largest integer of that many
+// bytes, and a final shorter block of m bytes is encoded with the number of characters of the
+// largest integer of m bytes; in base 62 a block of 32 bytes is encoded as 43 characters.
+type StreamEncoding struct {
+	base  uint64
+	alpha *alphabet.Alphabet
+	// shift is the number of bits of a character in a power-of-two base, and zero otherwise
+	shift uint
+	// blockBytes and blockChars are the sizes of a complete block before and after encoding
+	blockBytes int
+	blockChars int
+	// partialBytes maps the number of characters of a final partial block to its number of bytes
+	partialBytes map[int]int
+}
+
+// NewStreamEncoding creates a StreamEncoding for the specified base using the characters of an alphabet
+func NewStreamEncoding(base uint64, alpha *alphabet.Alphabet) (*StreamEncoding, error) {
+	if err := validateBase(base); err != nil {
+		return nil, err
+	}
+	if base > alpha.Len() {
+		return nil, fmt.Errorf("base [%d] exceeds alphabet size [%d]", base, alpha.Len())
+	}
+	e := &StreamEncoding{base: base, alpha: alpha, partialBytes: map[int]int{}}
+	if shift, ok := powerOfTwo(base); ok {
+		e.shift = shift
+		// a block is the least common multiple of 8 bits and the bits of a character
+		blockBits := 8 * int(e.shift) / gcd(8, int(e.shift))
+		e.blockBytes = blockBits / 8
+		e.blockChars = blockBits / int(e.shift)
+		for m := 1; m < e.blockBytes; m++ {
+			e.partialBytes[(8*m+int(e.shift)-1)/int(e.shift)] = m
+		}
+		return e, nil
+	}
+
+	e.blockBytes = StreamBlockSize
+	e.blockChars = BytesWidth(StreamBlockSize, base)
+	for m := 1; m < e.blockBytes; m++ {
+		e.partialBytes[BytesWidth(m, base)] = m
+	}
+	return e, nil
+}
+
+// EncodedLen returns the length of the encoding of n bytes
+func (e *StreamEncoding) EncodedLen(n int) int {
+	l := n / e.blockBytes * e.blockChars
+	if m := n % e.blockBytes; m > 0 {
+		l += e.encodedPartialLen(m)
+	}
+	return l
+}
+
+func (e *StreamEncoding) encodedPartialLen(m int) int {
+	if e.shift > 0 {
+		return (8*m + int(e.shift) - 1) / int(e.shift)
+	}
+	return BytesWidth(m, e.base)
+}
+
+// encodeBlock appends the encoding of a complete or final partial block to dst
+func (e *StreamEncoding) encodeBlock(dst []byte, src []byte) []byte {
+	if e.shift == 0 {
+		return append(dst, EncodeBytes(src, e.subAlphabet())...)
+	}
+
+	// the base of a valid stream encoding is a power of two no greater than the size of an alphabet
+	digits, _ := FromBytes(src, e.base)
+	str, _ := e.alpha.ToString(digits)
+	return append(dst, str...)
+}
+
+// decodeBlock appends the bytes of a complete or final partial block of encoded characters to dst
+func (e *StreamEncoding) decodeBlock(dst []byte, src []byte) ([]byte, error) {
+	size := e.blockBytes
+	if len(src) != e.blockChars {
+		var ok bool
+		if size, ok = e.partialBytes[len(src)]; !ok {
+			return dst, fmt.Errorf("invalid length [%d] of final block of encoded input", len(src))
+		}
+	}
+
+	if e.shift == 0 {
+		b, err := DecodeBytes(string(src), size, e.subAlphabet())
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, b...), nil
+	}
+
+	numeric, err := e.alpha.FromString(string(src))
+	if err != nil {
+		return dst, err
+	}
+	b, err := ToBytes(numeric, e.base)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, b...), nil
+}
+
+// subAlphabet returns the alphabet of the first base characters, whose size is the base of the
+// conversion performed by EncodeBytes and DecodeBytes
+func (e *StreamEncoding) subAlphabet() *alphabet.Alphabet {
+	if e.alpha.Len() == e.base {
+		return e.alpha
+	}
+	// a prefix of a valid alphabet is a valid alphabet
+	alpha, _ := alphabet.New(e.alpha.String()[:e.base])
+	return alpha
+}
+
+// NewEncoder returns a stream encoder writing the encoding of the bytes written to it to w;
+// the encoder must be closed to flush the final partial block.  As in encoding/base32, the
+// encoding is passed explicitly because its base and alphabet cannot be inferred from w
+func NewEncoder(enc *StreamEncoding, w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w, buf: make([]byte, 0, enc.blockBytes)}
+}
+
+type encoder struct {
+	enc *StreamEncoding
+	w   io.Writer
+	buf []byte
+	out []byte
+	err error
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	n := 0
+	for len(p) > 0 {
+		take := e.enc.blockBytes - len(e.buf)
+		if take > len(p) {
+			take = len(p)
+		}
+		e.buf = append(e.buf, p[:take]...)
+		p = p[take:]
+		n += take
+		if len(e.buf) == e.enc.blockBytes {
+			e.out = e.enc.encodeBlock(e.out, e.buf)
+			e.buf = e.buf[:0]
+		}
+		// write in chunks so that memory use does not depend on the size of p
+		if len(e.out) >= streamBufSize || len(p) == 0 {
+			if e.err = e.flush(); e.err != nil {
+				return n, e.err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close flushes the final partial block; it does not close the underlying writer
+func (e *encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if len(e.buf) > 0 {
+		e.out = e.enc.encodeBlock(e.out, e.buf)
+		e.buf = e.buf[:0]
+		e.err = e.flush()
+	}
+	return e.err
+}
+
+// flush writes the encoded output to the underlying writer
+func (e *encoder) flush() error {
+	if len(e.out) == 0 {
+		return nil
+	}
+	_, err := e.w.Write(e.out)
+	e.out = e.out[:0]
+	return err
+}
+
+// NewDecoder returns a stream decoder reading the bytes encoded by the characters read from r,
+// ignoring newline characters
+func NewDecoder(enc *StreamEncoding, r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r, buf: make([]byte, streamBufSize)}
+}
+
+type decoder struct {
+	enc *StreamEncoding
+	r   io.Reader
+	buf []byte
+	in  []byte
+	out []byte
+	err error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 && d.err == nil {
+		n, err := d.r.Read(d.buf)
+		for _, c := range d.buf[:n] {
+			if c != '\n' && c != '\r' {
+				d.in = append(d.in, c)
+			}
+		}
+
+		// decode the complete blocks, keeping the remainder for the next read
+		decoded := 0
+		for len(d.in)-decoded >= d.enc.blockChars {
+			d.out, d.err = d.enc.decodeBlock(d.out, d.in[decoded:decoded+d.enc.blockChars])
+			if d.err != nil {
+				break
+			}
+			decoded += d.enc.blockChars
+		}
+		d.in = append(d.in[:0], d.in[decoded:]...)
+
+		if d.err != nil {
+			break
+		}
+		if err == io.EOF {
+			if len(d.in) > 0 {
+				d.out, d.err = d.enc.decodeBlock(d.out, d.in)
+				d.in = d.in[:0]
+			}
+			if d.err == nil {
+				d.err = io.EOF
+			}
+		} else if err != nil {
+			d.err = err
+		}
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	if len(d.out) > 0 {
+		return n, nil
+	}
+	d.out = d.out[:0]
+	return n, d.err
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,235 @@
+package baseconv
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func mustStreamEncoding(t *testing.T, base uint64, chars string) *StreamEncoding {
+	t.Helper()
+	alpha, err := alphabet.New(chars)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	enc, err := NewStreamEncoding(base, alpha)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	return enc
+}
+
+// encodeStream encodes src writing chunkSize bytes at a time
+func encodeStream(t *testing.T, enc *StreamEncoding, src []byte, chunkSize int) string {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w := NewEncoder(enc, buf)
+	for len(src) > 0 {
+		n := chunkSize
+		if n > len(src) {
+			n = len(src)
+		}
+		if _, err := w.Write(src[:n]); err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		src = src[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestStreamMatchesStandardEncodings(t *testing.T) {
+	type testCase struct {
+		base   uint64
+		chars  string
+		encode func([]byte) string
+	}
+
+	tests := map[string]testCase{
+		"base32": {
+			base:   32,
+			chars:  "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567",
+			encode: base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString,
+		},
+		"hex": {
+			base:   16,
+			chars:  "0123456789abcdef",
+			encode: hex.EncodeToString,
+		},
+		"base64": {
+			base:   64,
+			chars:  "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/",
+			encode: base64.RawStdEncoding.EncodeToString,
+		},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			enc := mustStreamEncoding(t, test.base, test.chars)
+			for size := 0; size < 100; size++ {
+				src := make([]byte, size)
+				r.Read(src)
+				expected := test.encode(src)
+
+				for _, chunkSize := range []int{1, 3, 7, 4096} {
+					res := encodeStream(t, enc, src, chunkSize)
+					if res != expected {
+						t.Fatalf("encoding %s of %x not equal to expected %s", res, src, expected)
+					}
+				}
+				if enc.EncodedLen(size) != len(expected) {
+					t.Errorf("encoded length %d of %d bytes not equal to expected %d", enc.EncodedLen(size), size, len(expected))
+				}
+
+				dec, err := io.ReadAll(NewDecoder(enc, iotest.OneByteReader(strings.NewReader(expected))))
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				if !bytes.Equal(dec, src) {
+					t.Fatalf("decoding %x not equal to expected %x", dec, src)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamBlocks(t *testing.T) {
+	type testCase struct {
+		src      []byte
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			src:      []byte{},
+			expected: "",
+		},
+		"zero block": {
+			src:      make([]byte, 32),
+			expected: strings.Repeat("0", 43),
+		},
+		"max block": {
+			src:      bytes.Repeat([]byte{0xff}, 32),
+			expected: "YHJSKWDa6oz1al1yMhwzwM8llg7hJNUca2J5RoW8xP1",
+		},
+		"block and partial block": {
+			src:      []byte("hello world, this is a block of !"),
+			expected: "oKQkXavH86kxQYiF7THRTPJQoH2nMq3ESd982mqCOOc" + "0x",
+		},
+	}
+
+	enc := mustStreamEncoding(t, 62, alphabet.Default.String())
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res := encodeStream(t, enc, test.src, 5)
+			if res != test.expected {
+				t.Errorf("encoding %s not equal to expected %s", res, test.expected)
+			}
+			dec, err := io.ReadAll(NewDecoder(enc, strings.NewReader(test.expected+"\n")))
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, test.src) {
+				t.Errorf("decoding %x not equal to expected %x", dec, test.src)
+			}
+		})
+	}
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, base := range []uint64{2, 10, 36, 58, 62} {
+		enc := mustStreamEncoding(t, base, alphabet.Default.String())
+		for _, size := range []int{0, 1, 31, 32, 33, 64, 1000, 10000} {
+			src := make([]byte, size)
+			r.Read(src)
+			res := encodeStream(t, enc, src, 100)
+			if len(res) != enc.EncodedLen(size) {
+				t.Errorf("encoded length %d of %d bytes in base %d not equal to expected %d", len(res), size, base, enc.EncodedLen(size))
+			}
+			dec, err := io.ReadAll(NewDecoder(enc, strings.NewReader(res)))
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !bytes.Equal(dec, src) {
+				t.Errorf("decoding of %d bytes in base %d not equal to input", size, base)
+			}
+		}
+	}
+}
+
+func TestStreamDecodeWithError(t *testing.T) {
+	type testCase struct {
+		base  uint64
+		chars string
+		str   string
+	}
+
+	tests := map[string]testCase{
+		"invalid partial block length": {
+			base:  32,
+			chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567",
+			str:   "ABC",
+		},
+		"nonzero padding bits": {
+			base:  32,
+			chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567",
+			str:   "AB",
+		},
+		"character not in alphabet": {
+			base:  16,
+			chars: "0123456789abcdef",
+			str:   "0g",
+		},
+		"digit exceeds base": {
+			base:  16,
+			chars: alphabet.Default.String(),
+			str:   "0g",
+		},
+		"block overflow": {
+			base:  62,
+			chars: alphabet.Default.String(),
+			str:   strings.Repeat("Z", 43),
+		},
+		"invalid block length": {
+			base:  62,
+			chars: alphabet.Default.String(),
+			str:   "0",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			enc := mustStreamEncoding(t, test.base, test.chars)
+			_, err := io.ReadAll(NewDecoder(enc, strings.NewReader(test.str)))
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestNewStreamEncodingWithError(t *testing.T) {
+	_, err := NewStreamEncoding(1, alphabet.Default)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+	_, err = NewStreamEncoding(63, alphabet.Default)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
@@ -0,0 +1,41 @@
+package baseconv
+
+import (
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// uuidSize is the number of bytes of a UUID
+const uuidSize = 16
+
+// EncodeUUID converts a UUID to its fixed-width base 62 encoding with the default alphabet
+func EncodeUUID(u [16]byte) string {
+	return EncodeUUIDWithAlphabet(u, alphabet.Default)
+}
+
+// DecodeUUID converts a fixed-width base 62 encoding with the default alphabet to its UUID
+func DecodeUUID(str string) ([16]byte, error) {
+	return DecodeUUIDWithAlphabet(str, alphabet.Default)
+}
+
+// EncodeUUIDWithAlphabet converts a UUID to its fixed-width encoding in the base of the
+// size of an alphabet, padded to the number of digits of the largest UUID
+func EncodeUUIDWithAlphabet(u [16]byte, alpha *alphabet.Alphabet) string {
+	return EncodeBytes(u[:], alpha)
+}
+
+// DecodeUUIDWithAlphabet converts a fixed-width encoding in the base of the size of an
+// alphabet to its UUID
+func DecodeUUIDWithAlphabet(str string, alpha *alphabet.Alphabet) ([16]byte, error) {
+	u := [16]byte{}
+	b, err := DecodeBytes(str, uuidSize, alpha)
+	if err != nil {
+		return u, err
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// UUIDWidth returns the number of digits in the specified base of the largest UUID
+func UUIDWidth(base uint64) int {
+	return BytesWidth(uuidSize, base)
+}
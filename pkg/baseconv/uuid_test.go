@@ -0,0 +1,131 @@
+package baseconv
+
+import (
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+var (
+	testUUID = [16]byte{0x0f, 0x0e, 0x1d, 0x2c, 0x3b, 0x4a, 0x59, 0x68, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x00}
+	maxUUID  = [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	oneUUID  = [16]byte{15: 1}
+)
+
+func TestEncodeAndDecodeUUID(t *testing.T) {
+	type testCase struct {
+		uuid      [16]byte
+		alphaName string
+		expected  string
+	}
+
+	tests := map[string]testCase{
+		"base 62": {
+			uuid:      testUUID,
+			alphaName: "base62",
+			expected:  "0spkLK4lwrXCUlpoEZF8EE",
+		},
+		"base 62 with max UUID": {
+			uuid:      maxUUID,
+			alphaName: "base62",
+			expected:  "7N42dgm5tFLK9N8MT7fHC7",
+		},
+		"base 62 with padding": {
+			uuid:      oneUUID,
+			alphaName: "base62",
+			expected:  "0000000000000000000001",
+		},
+		"base 57": {
+			uuid:      testUUID,
+			alphaName: "shortuuid",
+			expected:  "4ggropxsVSJDopXVm7Ufai",
+		},
+		"base 57 with max UUID": {
+			uuid:      maxUUID,
+			alphaName: "shortuuid",
+			expected:  "oZEq7ovRbLq6UnGMPwc8B5",
+		},
+		"base 57 with padding": {
+			uuid:      oneUUID,
+			alphaName: "shortuuid",
+			expected:  "2222222222222222222223",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			alpha, err := alphabet.Get(test.alphaName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			enc := EncodeUUIDWithAlphabet(test.uuid, alpha)
+			if enc != test.expected {
+				t.Errorf("encoded %s not equal to expected %s", enc, test.expected)
+			}
+
+			dec, err := DecodeUUIDWithAlphabet(test.expected, alpha)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if dec != test.uuid {
+				t.Errorf("decoded %x not equal to expected %x", dec, test.uuid)
+			}
+		})
+	}
+}
+
+func TestEncodeUUIDWithDefaultAlphabet(t *testing.T) {
+	enc := EncodeUUID(testUUID)
+	if enc != "0spkLK4lwrXCUlpoEZF8EE" {
+		t.Errorf("encoded %s not equal to expected %s", enc, "0spkLK4lwrXCUlpoEZF8EE")
+	}
+	dec, err := DecodeUUID(enc)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if dec != testUUID {
+		t.Errorf("decoded %x not equal to expected %x", dec, testUUID)
+	}
+}
+
+func TestDecodeUUIDWithError(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"string shorter than width": {
+			str: "1",
+		},
+		"string longer than width": {
+			str: "00spkLK4lwrXCUlpoEZF8EE",
+		},
+		"character not in alphabet": {
+			str: "0spkLK4lwrXCUlpoEZF8E@",
+		},
+		"value exceeding 128 bits": {
+			str: "ZZZZZZZZZZZZZZZZZZZZZZ",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeUUID(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestUUIDWidth(t *testing.T) {
+	expected := map[uint64]int{2: 128, 16: 32, 32: 26, 57: 22, 62: 22}
+	for base, width := range expected {
+		if res := UUIDWidth(base); res != width {
+			t.Errorf("width %d for base %d not equal to expected %d", res, base, width)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package blocklist
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// maxSkips is the maximum number of values tried when skipping blocked values
+const maxSkips = 1000
+
+//go:embed english.txt
+var english string
+
+// leet maps each letter to the characters commonly substituted for it in leetspeak
+var leet = map[byte]string{
+	'a': "4@",
+	'b': "8",
+	'e': "3",
+	'g': "69",
+	'i': "1!",
+	'l': "1",
+	'o': "0",
+	's': "5$",
+	't': "7",
+	'z': "2",
+}
+
+// Blocklist matches strings containing blocked words, ignoring case and leetspeak substitutions
+type Blocklist struct {
+	words []string
+}
+
+// New creates a Blocklist of the specified words
+func New(words []string) *Blocklist {
+	b := &Blocklist{}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			b.words = append(b.words, word)
+		}
+	}
+	return b
+}
+
+// Default returns the Blocklist of the default English words
+func Default() *Blocklist {
+	b, _ := parse(strings.NewReader(english), "english.txt")
+	return b
+}
+
+// Load creates a Blocklist from a file of words, one per line, in which empty lines and
+// lines starting with # are ignored
+func Load(path string) (*Blocklist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parse(f, path)
+}
+
+// parse reads a blocklist of words, one per line, ignoring empty lines and comments
+func parse(r io.Reader, path string) (*Blocklist, error) {
+	scanner := bufio.NewScanner(r)
+	words := []string{}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return New(words), nil
+}
+
+// Len returns the number of words of the Blocklist
+func (b *Blocklist) Len() int {
+	return len(b.words)
+}
+
+// Match returns the first blocked word contained in a string and true, or false if the
+// string contains no blocked word
+func (b *Blocklist) Match(str string) (string, bool) {
+	str = strings.ToLower(str)
+	for _, word := range b.words {
+		if contains(str, word) {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+// Blocked reports whether a string contains a blocked word
+func (b *Blocklist) Blocked(str string) bool {
+	_, blocked := b.Match(str)
+	return blocked
+}
+
+// Skip returns the smallest value not less than num whose encoding contains no blocked word,
+// together with its encoding; skipping the values of an ID counter in this way re-maps IDs
+// deterministically so that every issued slug decodes to its ID
+func (b *Blocklist) Skip(num uint64, encode func(uint64) (string, error)) (uint64, string, error) {
+	for i := 0; i < maxSkips; i++ {
+		str, err := encode(num)
+		if err != nil {
+			return 0, "", err
+		}
+		if !b.Blocked(str) {
+			return num, str, nil
+		}
+		if num+1 < num {
+			break
+		}
+		num++
+	}
+	return 0, "", fmt.Errorf("no value with an unblocked encoding found after %d values", maxSkips)
+}
+
+// contains reports whether a lowercase string contains a word, allowing each letter of the
+// word to be replaced by its leetspeak substitutes
+func contains(str string, word string) bool {
+	for start := 0; start+len(word) <= len(str); start++ {
+		match := true
+		for i := 0; i < len(word); i++ {
+			c := str[start+i]
+			if c != word[i] && strings.IndexByte(leet[word[i]], c) == -1 {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
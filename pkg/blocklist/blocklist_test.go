@@ -0,0 +1,143 @@
+package blocklist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+func TestMatch(t *testing.T) {
+	type testCase struct {
+		str      string
+		blocked  bool
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"no blocked word": {
+			str:     "00000g8",
+			blocked: false,
+		},
+		"blocked word": {
+			str:      "xxshitxx",
+			blocked:  true,
+			expected: "shit",
+		},
+		"blocked word in upper case": {
+			str:      "0SHIT",
+			blocked:  true,
+			expected: "shit",
+		},
+		"blocked word with leetspeak substitutions": {
+			str:      "z5h17z",
+			blocked:  true,
+			expected: "shit",
+		},
+		"blocked word with mixed case and leetspeak": {
+			str:      "Pr1Ck",
+			blocked:  true,
+			expected: "prick",
+		},
+		"blocked word at end": {
+			str:      "00b00b",
+			blocked:  true,
+			expected: "boob",
+		},
+	}
+
+	b := Default()
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			word, blocked := b.Match(test.str)
+			if blocked != test.blocked {
+				t.Fatalf("blocked %t not equal to expected %t", blocked, test.blocked)
+			}
+			if word != test.expected {
+				t.Errorf("word %s not equal to expected %s", word, test.expected)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	b := New([]string{"  Bad ", "", "WORD"})
+	if b.Len() != 2 {
+		t.Fatalf("number of words %d not equal to expected %d", b.Len(), 2)
+	}
+	if !b.Blocked("xxb4dxx") {
+		t.Error("expected custom word with leetspeak substitution to be blocked")
+	}
+	if !b.Blocked("w0rd") {
+		t.Error("expected custom word with leetspeak substitution to be blocked")
+	}
+	if b.Blocked("shit") {
+		t.Error("expected word of default blocklist not to be blocked by custom blocklist")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "words")
+	if err := os.WriteFile(path, []byte("# custom words\n\nfoo\nbar\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if b.Len() != 2 {
+		t.Errorf("number of words %d not equal to expected %d", b.Len(), 2)
+	}
+	if !b.Blocked("xf00x") {
+		t.Error("expected word from file to be blocked")
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected non nil error for missing file")
+	}
+}
+
+func TestSkip(t *testing.T) {
+	codec, err := baseconv.NewCodec(36, alphabet.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1329 in base 36 is "10x" and 1330 through 1332 encode to "10y", "10z" and "110"
+	b := New([]string{"10y", "10z"})
+
+	num, str, err := b.Skip(1329, codec.EncodeToString)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if num != 1329 || str != "10x" {
+		t.Errorf("result %d [%s] not equal to expected %d [%s]", num, str, 1329, "10x")
+	}
+
+	num, str, err = b.Skip(1330, codec.EncodeToString)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if num != 1332 || str != "110" {
+		t.Errorf("result %d [%s] not equal to expected %d [%s]", num, str, 1332, "110")
+	}
+}
+
+func TestSkipWithError(t *testing.T) {
+	codec, err := baseconv.NewCodec(2, alphabet.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every encoding in base 2 with at least one character is blocked
+	b := New([]string{"0", "1"})
+	if _, _, err := b.Skip(0, codec.EncodeToString); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
@@ -0,0 +1,62 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// Format describes the presentation of an encoded string as an optional prefix followed by
+// groups of characters joined by a separator, such as usr_ABCD-EFGH-JKLM
+type Format struct {
+	// Prefix is prepended to the encoded string
+	Prefix string
+	// Separator is inserted between groups of characters
+	Separator string
+	// GroupSize is the number of characters in each group, counted from the start of the
+	// encoded string; characters are not grouped if GroupSize is zero
+	GroupSize int
+}
+
+// Validate checks that the separator does not contain characters of the alphabet,
+// which would make stripping a formatted string ambiguous
+func (f Format) Validate(a *alphabet.Alphabet) error {
+	if f.GroupSize < 0 {
+		return fmt.Errorf("group size [%d] cannot be negative", f.GroupSize)
+	}
+	if f.GroupSize > 0 && f.Separator == "" {
+		return fmt.Errorf("separator cannot be empty when grouping characters")
+	}
+	if strings.ContainsAny(f.Separator, a.String()) {
+		return fmt.Errorf("separator [%s] contains characters of the alphabet", f.Separator)
+	}
+	return nil
+}
+
+// Apply adds the prefix to an encoded string and separates its characters into groups
+func (f Format) Apply(str string) string {
+	if f.GroupSize <= 0 || len(str) <= f.GroupSize {
+		return f.Prefix + str
+	}
+
+	groups := []string{}
+	for len(str) > f.GroupSize {
+		groups = append(groups, str[:f.GroupSize])
+		str = str[f.GroupSize:]
+	}
+	groups = append(groups, str)
+	return f.Prefix + strings.Join(groups, f.Separator)
+}
+
+// Strip removes the prefix and separators from a formatted string to recover the encoded string
+func (f Format) Strip(str string) (string, error) {
+	if !strings.HasPrefix(str, f.Prefix) {
+		return "", fmt.Errorf("string [%s] does not have prefix [%s]", str, f.Prefix)
+	}
+	str = strings.TrimPrefix(str, f.Prefix)
+	if f.Separator != "" {
+		str = strings.ReplaceAll(str, f.Separator, "")
+	}
+	return str, nil
+}
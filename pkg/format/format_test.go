@@ -0,0 +1,163 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func TestApply(t *testing.T) {
+	type testCase struct {
+		format   Format
+		str      string
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"empty format": {
+			format:   Format{},
+			str:      "hBxM5A5",
+			expected: "hBxM5A5",
+		},
+		"prefix only": {
+			format:   Format{Prefix: "usr_"},
+			str:      "hBxM5A5",
+			expected: "usr_hBxM5A5",
+		},
+		"groups of equal size": {
+			format:   Format{Separator: "-", GroupSize: 4},
+			str:      "ABCDEFGHJKLM",
+			expected: "ABCD-EFGH-JKLM",
+		},
+		"groups with shorter final group": {
+			format:   Format{Separator: "-", GroupSize: 3},
+			str:      "hBxM5A5",
+			expected: "hBx-M5A-5",
+		},
+		"group size equal to length of string": {
+			format:   Format{Separator: "-", GroupSize: 7},
+			str:      "hBxM5A5",
+			expected: "hBxM5A5",
+		},
+		"prefix and groups": {
+			format:   Format{Prefix: "key_", Separator: ".", GroupSize: 2},
+			str:      "abcdef",
+			expected: "key_ab.cd.ef",
+		},
+		"separator without group size": {
+			format:   Format{Separator: "-"},
+			str:      "hBxM5A5",
+			expected: "hBxM5A5",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res := test.format.Apply(test.str)
+			if res != test.expected {
+				t.Errorf("result %s not equal to expected %s", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	type testCase struct {
+		format   Format
+		str      string
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"empty format": {
+			format:   Format{},
+			str:      "hBxM5A5",
+			expected: "hBxM5A5",
+		},
+		"prefix only": {
+			format:   Format{Prefix: "usr_"},
+			str:      "usr_hBxM5A5",
+			expected: "hBxM5A5",
+		},
+		"prefix and groups": {
+			format:   Format{Prefix: "key_", Separator: "-", GroupSize: 4},
+			str:      "key_ABCD-EFGH-JKLM",
+			expected: "ABCDEFGHJKLM",
+		},
+		"separators in unexpected positions": {
+			format:   Format{Separator: "-", GroupSize: 4},
+			str:      "AB-CDEFGH-JK-LM",
+			expected: "ABCDEFGHJKLM",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			res, err := test.format.Strip(test.str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res != test.expected {
+				t.Errorf("result %s not equal to expected %s", res, test.expected)
+			}
+		})
+	}
+}
+
+func TestStripWithError(t *testing.T) {
+	type testCase struct {
+		format Format
+		str    string
+	}
+
+	tests := map[string]testCase{
+		"missing prefix": {
+			format: Format{Prefix: "usr_"},
+			str:    "hBxM5A5",
+		},
+		"different prefix": {
+			format: Format{Prefix: "usr_"},
+			str:    "org_hBxM5A5",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := test.format.Strip(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestValidateWithError(t *testing.T) {
+	type testCase struct {
+		format Format
+	}
+
+	tests := map[string]testCase{
+		"negative group size": {
+			format: Format{Separator: "-", GroupSize: -1},
+		},
+		"empty separator with group size": {
+			format: Format{GroupSize: 4},
+		},
+		"separator in alphabet": {
+			format: Format{Separator: "x", GroupSize: 4},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.format.Validate(alphabet.Default)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package hashids
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+const (
+	// minAlphabetLen is the minimum number of characters of an alphabet
+	minAlphabetLen = 16
+	// sepDiv is the target ratio of alphabet characters to separators
+	sepDiv = 3.5
+	// guardDiv is the target ratio of alphabet characters to guards
+	guardDiv = 12
+	// defaultSeps are the preferred separators, which are removed from the alphabet
+	defaultSeps = "cfhistuCFHISTU"
+)
+
+// DefaultAlphabet is the default alphabet of the reference Hashids implementation
+var DefaultAlphabet, _ = alphabet.New("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890")
+
+// HashID encodes lists of non-negative integers into a single string compatible with Hashids
+type HashID struct {
+	alphabet  []rune
+	seps      []rune
+	guards    []rune
+	salt      []rune
+	minLength int
+}
+
+// New creates a HashID from a salt, the minimum length of encoded strings and an alphabet of at
+// least 16 characters, with a nil alphabet meaning DefaultAlphabet
+func New(salt string, minLength int, alpha *alphabet.Alphabet) (*HashID, error) {
+	if alpha == nil {
+		alpha = DefaultAlphabet
+	}
+	if alpha.Len() < minAlphabetLen {
+		return nil, fmt.Errorf("alphabet must contain at least %d characters", minAlphabetLen)
+	}
+	if strings.Contains(alpha.String(), " ") {
+		return nil, errors.New("alphabet cannot contain spaces")
+	}
+	if minLength < 0 {
+		return nil, errors.New("minimum length cannot be negative")
+	}
+
+	h := &HashID{salt: []rune(salt), minLength: minLength}
+
+	// separators are the preferred separators contained in the alphabet
+	chars := []rune{}
+	for _, c := range alpha.String() {
+		if strings.ContainsRune(defaultSeps, c) {
+			continue
+		}
+		chars = append(chars, c)
+	}
+	seps := []rune{}
+	for _, c := range defaultSeps {
+		if strings.ContainsRune(alpha.String(), c) {
+			seps = append(seps, c)
+		}
+	}
+	shuffle(seps, h.salt)
+
+	if len(seps) == 0 || float64(len(chars))/float64(len(seps)) > sepDiv {
+		sepsLen := int(math.Ceil(float64(len(chars)) / sepDiv))
+		if sepsLen == 1 {
+			sepsLen = 2
+		}
+		if sepsLen > len(seps) {
+			diff := sepsLen - len(seps)
+			seps = append(seps, chars[:diff]...)
+			chars = chars[diff:]
+		} else {
+			seps = seps[:sepsLen]
+		}
+	}
+	shuffle(chars, h.salt)
+
+	guardCount := int(math.Ceil(float64(len(chars)) / guardDiv))
+	if len(chars) < 3 {
+		h.guards = seps[:guardCount]
+		seps = seps[guardCount:]
+	} else {
+		h.guards = chars[:guardCount]
+		chars = chars[guardCount:]
+	}
+
+	h.alphabet = chars
+	h.seps = seps
+	return h, nil
+}
+
+// Encode converts a list of non-negative integers to a single string
+func (h *HashID) Encode(nums []uint64) (string, error) {
+	if len(nums) == 0 {
+		return "", errors.New("cannot encode an empty list")
+	}
+
+	alpha := append([]rune{}, h.alphabet...)
+	alphaLen := uint64(len(alpha))
+
+	numsHash := uint64(0)
+	for i, n := range nums {
+		numsHash += n % uint64(i+100)
+	}
+
+	lottery := alpha[numsHash%alphaLen]
+	res := []rune{lottery}
+	buf := make([]rune, 0, 1+len(h.salt)+len(alpha))
+
+	for i, n := range nums {
+		buf = append(append(append(buf[:0], lottery), h.salt...), alpha...)
+		shuffle(alpha, buf[:len(alpha)])
+		last := hash(n, alpha)
+		res = append(res, last...)
+
+		if i+1 < len(nums) {
+			n %= uint64(last[0]) + uint64(i)
+			res = append(res, h.seps[n%uint64(len(h.seps))])
+		}
+	}
+
+	if len(res) < h.minLength {
+		guard := h.guards[(numsHash+uint64(res[0]))%uint64(len(h.guards))]
+		res = append([]rune{guard}, res...)
+
+		if len(res) < h.minLength {
+			guard = h.guards[(numsHash+uint64(res[2]))%uint64(len(h.guards))]
+			res = append(res, guard)
+		}
+	}
+
+	half := len(alpha) / 2
+	for len(res) < h.minLength {
+		shuffle(alpha, append([]rune{}, alpha...))
+		padded := append(append(append([]rune{}, alpha[half:]...), res...), alpha[:half]...)
+		res = padded
+		if excess := len(res) - h.minLength; excess > 0 {
+			res = res[excess/2 : excess/2+h.minLength]
+		}
+	}
+
+	return string(res), nil
+}
+
+// Decode converts a string to the list of integers it encodes
+func (h *HashID) Decode(str string) ([]uint64, error) {
+	parts := splitRunes([]rune(str), h.guards)
+	i := 0
+	if len(parts) == 2 || len(parts) == 3 {
+		i = 1
+	}
+	breakdown := parts[i]
+	if len(breakdown) == 0 {
+		return nil, fmt.Errorf("cannot decode [%s]", str)
+	}
+
+	alpha := append([]rune{}, h.alphabet...)
+	lottery := breakdown[0]
+	buf := make([]rune, 0, 1+len(h.salt)+len(alpha))
+
+	nums := []uint64{}
+	for _, sub := range splitRunes(breakdown[1:], h.seps) {
+		buf = append(append(append(buf[:0], lottery), h.salt...), alpha...)
+		shuffle(alpha, buf[:len(alpha)])
+		n, err := unhash(sub, alpha)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode [%s]: %v", str, err)
+		}
+		nums = append(nums, n)
+	}
+
+	// only the canonical encoding of a list decodes successfully
+	enc, err := h.Encode(nums)
+	if err != nil || enc != str {
+		return nil, fmt.Errorf("cannot decode [%s]", str)
+	}
+	return nums, nil
+}
+
+// shuffle permutes an alphabet in place deterministically by a salt
+func shuffle(alpha []rune, salt []rune) {
+	if len(salt) == 0 {
+		return
+	}
+	for i, v, p := len(alpha)-1, 0, 0; i > 0; i-- {
+		v %= len(salt)
+		n := int(salt[v])
+		p += n
+		j := (n + v + p) % i
+		alpha[i], alpha[j] = alpha[j], alpha[i]
+		v++
+	}
+}
+
+// hash converts an integer to its representation in the base of the alphabet
+func hash(num uint64, alpha []rune) []rune {
+	base := uint64(len(alpha))
+	res := []rune{}
+	for {
+		res = append([]rune{alpha[num%base]}, res...)
+		num /= base
+		if num == 0 {
+			return res
+		}
+	}
+}
+
+// unhash converts a representation in the base of the alphabet to its integer
+func unhash(str []rune, alpha []rune) (uint64, error) {
+	if len(str) == 0 {
+		return 0, errors.New("empty number")
+	}
+	base := uint64(len(alpha))
+	num := uint64(0)
+	for _, c := range str {
+		i := indexRune(alpha, c)
+		if i == -1 {
+			return 0, fmt.Errorf("character [%c] not found in alphabet", c)
+		}
+		if num > (math.MaxUint64-uint64(i))/base {
+			return 0, errors.New("value overflows uint64")
+		}
+		num = num*base + uint64(i)
+	}
+	return num, nil
+}
+
+// splitRunes splits a string at each occurrence of any of the separators
+func splitRunes(str []rune, seps []rune) [][]rune {
+	parts := [][]rune{}
+	start := 0
+	for i, c := range str {
+		if indexRune(seps, c) != -1 {
+			parts = append(parts, str[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, str[start:])
+}
+
+func indexRune(chars []rune, c rune) int {
+	for i, r := range chars {
+		if r == c {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,181 @@
+package hashids
+
+import (
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func TestEncodeAndDecode(t *testing.T) {
+	type testCase struct {
+		salt      string
+		minLength int
+		alphaName string
+		nums      []uint64
+		expected  string // empty to check only the round trip
+	}
+
+	tests := map[string]testCase{
+		"single number with salt": {
+			salt:     "this is my salt",
+			nums:     []uint64{12345},
+			expected: "NkK9",
+		},
+		"multiple numbers with salt": {
+			salt:     "this is my salt",
+			nums:     []uint64{683, 94108, 123, 5},
+			expected: "aBMswoO2UB3Sj",
+		},
+		"sequence with salt": {
+			salt:     "this is my salt",
+			nums:     []uint64{1, 2, 3},
+			expected: "laHquq",
+		},
+		"minimum length with salt": {
+			salt:      "this is my salt",
+			minLength: 8,
+			nums:      []uint64{1},
+			expected:  "gB0NV05e",
+		},
+		"sequence without salt": {
+			nums:     []uint64{1, 2, 3},
+			expected: "o2fXhV",
+		},
+		"zero with salt": {
+			salt:     "this is my salt",
+			nums:     []uint64{0},
+			expected: "",
+		},
+		"large minimum length": {
+			salt:      "this is my salt",
+			minLength: 50,
+			nums:      []uint64{1, 2, 3},
+			expected:  "",
+		},
+		"maximum uint64": {
+			salt:     "this is my salt",
+			nums:     []uint64{18446744073709551615},
+			expected: "",
+		},
+		"named alphabet": {
+			salt:      "this is my salt",
+			alphaName: "base36",
+			nums:      []uint64{1, 2, 3},
+			expected:  "",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			var alpha *alphabet.Alphabet
+			if test.alphaName != "" {
+				var err error
+				alpha, err = alphabet.Get(test.alphaName)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			h, err := New(test.salt, test.minLength, alpha)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+
+			enc, err := h.Encode(test.nums)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if test.expected != "" && enc != test.expected {
+				t.Errorf("encoded %s not equal to expected %s", enc, test.expected)
+			}
+			if len(enc) < test.minLength {
+				t.Errorf("encoded %s shorter than minimum length %d", enc, test.minLength)
+			}
+
+			dec, err := h.Decode(enc)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(dec) != len(test.nums) {
+				t.Errorf("decoded %v not equal to expected %v", dec, test.nums)
+				return
+			}
+			for i := range dec {
+				if dec[i] != test.nums[i] {
+					t.Errorf("decoded %v not equal to expected %v", dec, test.nums)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestNewWithError(t *testing.T) {
+	short, err := alphabet.New("0123456789abcde")
+	if err != nil {
+		t.Fatal(err)
+	}
+	withSpace, err := alphabet.New("0123456789abcdef ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := New("salt", 0, short); err == nil {
+		t.Error("expected non nil error for alphabet shorter than minimum")
+	}
+	if _, err := New("salt", 0, withSpace); err == nil {
+		t.Error("expected non nil error for alphabet containing a space")
+	}
+	if _, err := New("salt", -1, nil); err == nil {
+		t.Error("expected non nil error for negative minimum length")
+	}
+}
+
+func TestEncodeWithError(t *testing.T) {
+	h, err := New("this is my salt", 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if _, err := h.Encode([]uint64{}); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestDecodeWithError(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			str: "",
+		},
+		"character not in alphabet": {
+			str: "NkK9@",
+		},
+		"encoding with different salt": {
+			str: "o2fXhV",
+		},
+		"non canonical encoding": {
+			str: "NkK8",
+		},
+		"value overflowing uint64": {
+			str: "NkK9NkK9NkK9NkK9NkK9",
+		},
+	}
+
+	h, err := New("this is my salt", 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := h.Decode(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package ksuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+const (
+	// size is the number of bytes of a KSUID
+	size = 20
+	// epoch is the Unix time in seconds from which KSUID timestamps are measured
+	epoch = 1_400_000_000
+)
+
+// ascii62 is the base 62 alphabet in which KSUIDs are encoded
+var ascii62, _ = alphabet.Get("ascii62")
+
+// KSUID is a K-sortable unique identifier: a 32-bit timestamp in seconds since the KSUID
+// epoch followed by a 128-bit random payload
+type KSUID [size]byte
+
+// New generates a KSUID from the current time and a cryptographic random payload
+func New() (KSUID, error) {
+	return newKSUID(time.Now(), rand.Reader)
+}
+
+// Parse parses a KSUID from its 27-character base 62 encoding
+func Parse(str string) (KSUID, error) {
+	k := KSUID{}
+	b, err := baseconv.DecodeBytes(str, size, ascii62)
+	if err != nil {
+		return k, err
+	}
+	copy(k[:], b)
+	return k, nil
+}
+
+// String returns the 27-character base 62 encoding of a KSUID
+func (k KSUID) String() string {
+	return baseconv.EncodeBytes(k[:], ascii62)
+}
+
+// Timestamp returns the timestamp of a KSUID in seconds since the KSUID epoch
+func (k KSUID) Timestamp() uint32 {
+	return binary.BigEndian.Uint32(k[:4])
+}
+
+// Time returns the time of the timestamp of a KSUID
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(k.Timestamp())+epoch, 0).UTC()
+}
+
+// Payload returns the hexadecimal random payload of a KSUID
+func (k KSUID) Payload() string {
+	return hex.EncodeToString(k[4:])
+}
+
+func newKSUID(now time.Time, r io.Reader) (KSUID, error) {
+	k := KSUID{}
+	ts := now.Unix() - epoch
+	if ts < 0 || ts > 1<<32-1 {
+		return k, errors.New("time cannot be represented by a KSUID timestamp")
+	}
+	binary.BigEndian.PutUint32(k[:4], uint32(ts))
+	if _, err := io.ReadFull(r, k[4:]); err != nil {
+		return k, err
+	}
+	return k, nil
+}
@@ -0,0 +1,110 @@
+package ksuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	k, err := Parse("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if k.String() != "0ujtsYcgvSTl8PAuAdqWYSMnLOv" {
+		t.Errorf("string %s not equal to expected %s", k, "0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	}
+	if k.Timestamp() != 107608047 {
+		t.Errorf("timestamp %d not equal to expected %d", k.Timestamp(), 107608047)
+	}
+	if k.Payload() != "b5a1cd34b5f99d1154fb6853345c9735" {
+		t.Errorf("payload %s not equal to expected %s", k.Payload(), "b5a1cd34b5f99d1154fb6853345c9735")
+	}
+	if !k.Time().Equal(time.Unix(1507608047, 0)) {
+		t.Errorf("time %s not equal to expected %s", k.Time(), time.Unix(1507608047, 0).UTC())
+	}
+}
+
+func TestParseWithError(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			str: "",
+		},
+		"too short": {
+			str: "0ujtsYcgvSTl8PAuAdqWYSMnLO",
+		},
+		"too long": {
+			str: "0ujtsYcgvSTl8PAuAdqWYSMnLOv0",
+		},
+		"invalid character": {
+			str: "0ujtsYcgvSTl8PAuAdqWYSMnLO-",
+		},
+		"overflow": {
+			str: "aWgEPTl1tmebfsQzFP4bxwgy80W",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	payload := bytes.Repeat([]byte{0xab}, 16)
+	k, err := newKSUID(time.Unix(1507608047, 0), bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if k.Timestamp() != 107608047 {
+		t.Errorf("timestamp %d not equal to expected %d", k.Timestamp(), 107608047)
+	}
+	if k.Payload() != "abababababababababababababababab" {
+		t.Errorf("payload %s not equal to expected %s", k.Payload(), "abababababababababababababababab")
+	}
+
+	parsed, err := Parse(k.String())
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if parsed != k {
+		t.Errorf("parsed %s not equal to expected %s", parsed, k)
+	}
+}
+
+func TestNewWithError(t *testing.T) {
+	type testCase struct {
+		now     time.Time
+		payload []byte
+	}
+
+	tests := map[string]testCase{
+		"time before epoch": {
+			now:     time.Unix(epoch-1, 0),
+			payload: bytes.Repeat([]byte{0xab}, 16),
+		},
+		"insufficient payload": {
+			now:     time.Unix(1507608047, 0),
+			payload: bytes.Repeat([]byte{0xab}, 15),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := newKSUID(test.now, bytes.NewReader(test.payload))
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package obfuscate
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/bits"
+	"sync"
+)
+
+// rounds is the number of rounds of the Feistel network
+const rounds = 8
+
+// Permutation is a keyed bijection of the integers in [0, max] to themselves that maps
+// sequential integers to random-looking integers of the same domain; a Permutation is safe
+// for concurrent use
+type Permutation struct {
+	max      uint64
+	halfBits uint
+	mask     uint64
+
+	// mu guards the keyed hash and the buffers of the round function
+	mu  sync.Mutex
+	mac hash.Hash
+	msg []byte
+	sum []byte
+}
+
+// New creates a Permutation of the integers in [0, max] determined by a secret key
+func New(key []byte, max uint64) (*Permutation, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key cannot be empty")
+	}
+
+	// the Feistel network permutes the smallest even number of bits covering the domain
+	halfBits := uint(bits.Len64(max)+1) / 2
+	return &Permutation{
+		max:      max,
+		halfBits: halfBits,
+		mask:     1<<halfBits - 1,
+		mac:      hmac.New(sha256.New, key),
+		msg:      make([]byte, 9),
+	}, nil
+}
+
+// Max returns the largest integer of the domain of the Permutation
+func (p *Permutation) Max() uint64 {
+	return p.max
+}
+
+// Apply maps an integer to its obfuscated value
+func (p *Permutation) Apply(num uint64) (uint64, error) {
+	if err := p.validate(num); err != nil {
+		return 0, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// cycle walking keeps the result in the domain, terminating because the network is a
+	// bijection and the walk starts in the domain
+	for {
+		num = p.encrypt(num)
+		if num <= p.max {
+			return num, nil
+		}
+	}
+}
+
+// Invert maps an obfuscated value to its original integer
+func (p *Permutation) Invert(num uint64) (uint64, error) {
+	if err := p.validate(num); err != nil {
+		return 0, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for {
+		num = p.decrypt(num)
+		if num <= p.max {
+			return num, nil
+		}
+	}
+}
+
+func (p *Permutation) validate(num uint64) error {
+	if num > p.max {
+		return fmt.Errorf("value [%d] exceeds maximum [%d] of permutation", num, p.max)
+	}
+	return nil
+}
+
+func (p *Permutation) encrypt(num uint64) uint64 {
+	left, right := num>>p.halfBits, num&p.mask
+	for r := 0; r < rounds; r++ {
+		left, right = right, left^p.round(r, right)
+	}
+	return left<<p.halfBits | right
+}
+
+func (p *Permutation) decrypt(num uint64) uint64 {
+	left, right := num>>p.halfBits, num&p.mask
+	for r := rounds - 1; r >= 0; r-- {
+		left, right = right^p.round(r, left), left
+	}
+	return left<<p.halfBits | right
+}
+
+// round is the keyed round function of the Feistel network
+func (p *Permutation) round(r int, half uint64) uint64 {
+	p.msg[0] = byte(r)
+	binary.BigEndian.PutUint64(p.msg[1:], half)
+
+	p.mac.Reset()
+	p.mac.Write(p.msg)
+	p.sum = p.mac.Sum(p.sum[:0])
+	return binary.BigEndian.Uint64(p.sum) & p.mask
+}
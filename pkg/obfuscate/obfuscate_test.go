@@ -0,0 +1,178 @@
+package obfuscate
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestPermutationIsBijection(t *testing.T) {
+	type testCase struct {
+		max uint64
+	}
+
+	tests := map[string]testCase{
+		"domain of a single value": {
+			max: 0,
+		},
+		"domain of two values": {
+			max: 1,
+		},
+		"domain of one base 62 digit": {
+			max: 61,
+		},
+		"domain of two base 62 digits": {
+			max: 3843,
+		},
+		"domain with odd number of bits": {
+			max: 1<<13 - 1,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			p, err := New([]byte("secret"), test.max)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+
+			seen := map[uint64]bool{}
+			for num := uint64(0); num <= test.max; num++ {
+				obf, err := p.Apply(num)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				if obf > test.max {
+					t.Fatalf("obfuscated value %d of %d exceeds maximum %d", obf, num, test.max)
+				}
+				if seen[obf] {
+					t.Fatalf("obfuscated value %d of %d is repeated", obf, num)
+				}
+				seen[obf] = true
+
+				inv, err := p.Invert(obf)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				if inv != num {
+					t.Fatalf("inverted value %d not equal to expected %d", inv, num)
+				}
+			}
+		})
+	}
+}
+
+func TestPermutationRoundTripWithLargeDomain(t *testing.T) {
+	type testCase struct {
+		max uint64
+	}
+
+	tests := map[string]testCase{
+		"domain of seven base 62 digits": {
+			max: 3_521_614_606_207,
+		},
+		"domain of uint64": {
+			max: math.MaxUint64,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			p, err := New([]byte("secret"), test.max)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			for _, num := range []uint64{0, 1, 2, 3, 1000, test.max / 2, test.max - 1, test.max} {
+				obf, err := p.Apply(num)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				inv, err := p.Invert(obf)
+				if err != nil {
+					t.Fatalf("unexpected non nil error: %v", err)
+				}
+				if inv != num {
+					t.Errorf("inverted value %d not equal to expected %d", inv, num)
+				}
+			}
+		})
+	}
+}
+
+func TestPermutationConcurrentUse(t *testing.T) {
+	p, err := New([]byte("secret"), 3_521_614_606_207)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	expected := make([]uint64, 100)
+	for i := range expected {
+		expected[i], _ = p.Apply(uint64(i))
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range expected {
+				obf, _ := p.Apply(uint64(i))
+				if obf != expected[i] {
+					t.Errorf("obfuscated value %d not equal to expected %d", obf, expected[i])
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPermutationHidesSequence(t *testing.T) {
+	p, err := New([]byte("secret"), 3_521_614_606_207)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	q, err := New([]byte("other secret"), 3_521_614_606_207)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	sequential := 0
+	sameForKeys := 0
+	prev, _ := p.Apply(0)
+	for num := uint64(1); num <= 100; num++ {
+		obf, _ := p.Apply(num)
+		if obf == prev+1 {
+			sequential++
+		}
+		prev = obf
+
+		other, _ := q.Apply(num)
+		if other == obf {
+			sameForKeys++
+		}
+	}
+	if sequential > 0 {
+		t.Errorf("%d sequential integers have sequential obfuscated values", sequential)
+	}
+	if sameForKeys > 0 {
+		t.Errorf("%d integers have the same obfuscated value for different keys", sameForKeys)
+	}
+}
+
+func TestPermutationWithError(t *testing.T) {
+	if _, err := New(nil, 100); err == nil {
+		t.Error("expected non nil error for empty key")
+	}
+
+	p, err := New([]byte("secret"), 100)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if _, err := p.Apply(101); err == nil {
+		t.Error("expected non nil error for applying value exceeding maximum")
+	}
+	if _, err := p.Invert(101); err == nil {
+		t.Error("expected non nil error for inverting value exceeding maximum")
+	}
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// Limits bounds the size of the requests accepted by the handler
+type Limits struct {
+	// MaxInputLen is the maximum length of a single value to encode or decode
+	MaxInputLen int
+	// MaxBatchLen is the maximum number of values in a batch request
+	MaxBatchLen int
+	// MaxBodyBytes is the maximum size of a batch request body
+	MaxBodyBytes int64
+}
+
+// DefaultLimits are the limits used by the serve command unless otherwise specified
+var DefaultLimits = Limits{
+	MaxInputLen:  1024,
+	MaxBatchLen:  1000,
+	MaxBodyBytes: 1 << 20,
+}
+
+// NewHandler returns a handler serving the endpoints
+//
+//	GET  /encode?n=NUM&base=BASE   encodes a base 10 integer
+//	GET  /decode?s=STR&base=BASE   decodes a string representation
+//	POST /encode?base=BASE         encodes each base 10 integer in a JSON array body
+//	POST /decode?base=BASE         decodes each string representation in a JSON array body
+//
+// where the optional query parameters alphabet, digits and pad configure the conversion
+// as the corresponding flags of the CLI
+func NewHandler(limits Limits) http.Handler {
+	h := &handler{limits: limits}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/encode", h.handle(h.encode, "n"))
+	mux.HandleFunc("/decode", h.handle(h.decode, "s"))
+	return mux
+}
+
+// Error is the structured error included in a response
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Result is the response to a single conversion
+type Result struct {
+	Input  string `json:"input"`
+	Output string `json:"output,omitempty"`
+	Error  *Error `json:"error,omitempty"`
+}
+
+// BatchResult is the response to a batch of conversions
+type BatchResult struct {
+	Results []Result `json:"results"`
+}
+
+// errorResponse is the response to a request that could not be processed
+type errorResponse struct {
+	Error *Error `json:"error"`
+}
+
+// apiError is an error with the HTTP status and code used to report it
+type apiError struct {
+	status int
+	code   string
+	msg    string
+}
+
+func (e *apiError) Error() string {
+	return e.msg
+}
+
+func badRequest(code string, format string, a ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: code, msg: fmt.Sprintf(format, a...)}
+}
+
+// toError converts an error to its structured representation and HTTP status
+func toError(err error) (*Error, int) {
+	var aerr *apiError
+	if errors.As(err, &aerr) {
+		return &Error{Code: aerr.code, Message: aerr.msg}, aerr.status
+	}
+	return &Error{Code: "invalid_input", Message: err.Error()}, http.StatusBadRequest
+}
+
+// params are the query parameters configuring a conversion
+type params struct {
+	base   uint64
+	digits uint64
+	pad    bool
+	alpha  *alphabet.Alphabet
+}
+
+type converter func(input string, p *params) (string, error)
+
+type handler struct {
+	limits Limits
+}
+
+// handle returns a handler func that applies a converter to the value of the specified query
+// parameter for GET requests and to each value of a JSON array body for POST requests
+func (h *handler) handle(convert converter, key string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			writeError(w, &apiError{
+				status: http.StatusMethodNotAllowed,
+				code:   "method_not_allowed",
+				msg:    fmt.Sprintf("method %s not allowed", r.Method),
+			})
+			return
+		}
+
+		p, err := h.parseParams(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			input := r.URL.Query().Get(key)
+			if input == "" {
+				writeError(w, badRequest("missing_parameter", "missing required parameter %s", key))
+				return
+			}
+			output, err := h.convert(convert, input, p)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, Result{Input: input, Output: output})
+
+		case http.MethodPost:
+			inputs, err := h.parseBatch(w, r)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			results := make([]Result, len(inputs))
+			for i, input := range inputs {
+				results[i].Input = input
+				output, err := h.convert(convert, input, p)
+				if err != nil {
+					results[i].Error, _ = toError(err)
+					continue
+				}
+				results[i].Output = output
+			}
+			writeJSON(w, http.StatusOK, BatchResult{Results: results})
+		}
+	}
+}
+
+// convert applies a converter to an input after checking its length
+func (h *handler) convert(convert converter, input string, p *params) (string, error) {
+	if len(input) > h.limits.MaxInputLen {
+		return "", badRequest("input_too_large", "input length [%d] exceeds limit [%d]", len(input), h.limits.MaxInputLen)
+	}
+	return convert(input, p)
+}
+
+// parseBatch parses a request body containing a JSON array of strings
+func (h *handler) parseBatch(w http.ResponseWriter, r *http.Request) ([]string, error) {
+	body := http.MaxBytesReader(w, r.Body, h.limits.MaxBodyBytes)
+	dec := json.NewDecoder(body)
+	inputs := []string{}
+	err := dec.Decode(&inputs)
+	if err == nil {
+		// a second value or any other non-whitespace data after the array is invalid
+		if err = dec.Decode(&json.RawMessage{}); err == io.EOF {
+			err = nil
+		} else if err == nil {
+			err = errors.New("unexpected data after JSON array")
+		}
+	}
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return nil, &apiError{
+				status: http.StatusRequestEntityTooLarge,
+				code:   "body_too_large",
+				msg:    fmt.Sprintf("request body exceeds limit [%d] bytes", h.limits.MaxBodyBytes),
+			}
+		}
+		return nil, badRequest("invalid_json", "request body must be a JSON array of strings: %v", err)
+	}
+	if len(inputs) > h.limits.MaxBatchLen {
+		return nil, &apiError{
+			status: http.StatusRequestEntityTooLarge,
+			code:   "batch_too_large",
+			msg:    fmt.Sprintf("batch length [%d] exceeds limit [%d]", len(inputs), h.limits.MaxBatchLen),
+		}
+	}
+	return inputs, nil
+}
+
+func (h *handler) encode(input string, p *params) (string, error) {
+	num, ok := new(big.Int).SetString(input, 10)
+	if !ok || num.Sign() < 0 {
+		return "", badRequest("invalid_input", "could not parse %s as a non-negative base 10 integer", input)
+	}
+
+	enc, err := baseconv.FromBase10Any(num, p.base)
+	if err != nil {
+		return "", err
+	}
+	if p.digits > 0 && uint64(len(enc)) > p.digits {
+		return "", badRequest("invalid_input", "cannot encode %s in base %d with %d digits", input, p.base, p.digits)
+	}
+
+	str, err := p.alpha.ToString(enc)
+	if err != nil {
+		return "", err
+	}
+	if p.pad {
+		return p.alpha.Pad(str, int(p.digits))
+	}
+	return str, nil
+}
+
+func (h *handler) decode(input string, p *params) (string, error) {
+	numeric, err := p.alpha.FromString(input)
+	if err != nil {
+		return "", err
+	}
+
+	dec, err := baseconv.ToBase10Any(numeric, p.base)
+	if err != nil {
+		return "", err
+	}
+	return dec.String(), nil
+}
+
+func (h *handler) parseParams(r *http.Request) (*params, error) {
+	q := r.URL.Query()
+	p := &params{}
+
+	base, err := strconv.ParseUint(q.Get("base"), 10, 64)
+	if err != nil {
+		return nil, badRequest("invalid_parameter", "could not parse base [%s] as uint64", q.Get("base"))
+	}
+	p.base = base
+
+	if digits := q.Get("digits"); digits != "" {
+		p.digits, err = strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			return nil, badRequest("invalid_parameter", "could not parse digits [%s] as uint64", digits)
+		}
+		// bound the length of padded output in the same way as the length of input
+		if p.digits > uint64(h.limits.MaxInputLen) {
+			return nil, badRequest("invalid_parameter", "digits [%d] exceeds limit [%d]", p.digits, h.limits.MaxInputLen)
+		}
+	}
+
+	if pad := q.Get("pad"); pad != "" {
+		p.pad, err = strconv.ParseBool(pad)
+		if err != nil {
+			return nil, badRequest("invalid_parameter", "could not parse pad [%s] as bool", pad)
+		}
+		if p.pad && p.digits == 0 {
+			return nil, badRequest("invalid_parameter", "must specify digits to pad output")
+		}
+	}
+
+	alphaName := alphabet.DefaultName
+	if name := q.Get("alphabet"); name != "" {
+		alphaName = name
+	}
+	p.alpha, err = alphabet.Get(alphaName)
+	if err != nil {
+		return nil, badRequest("invalid_parameter", "%v", err)
+	}
+
+	if p.base < 2 || p.base > p.alpha.Len() {
+		return nil, badRequest("invalid_parameter", "base [%d] must be between 2 and alphabet size [%d]", p.base, p.alpha.Len())
+	}
+	return p, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, status := toError(err)
+	writeJSON(w, status, errorResponse{Error: e})
+}
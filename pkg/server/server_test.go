@@ -0,0 +1,264 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	type testCase struct {
+		target   string
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"encode": {
+			target:   "/encode?n=1000000000001&base=62",
+			expected: "hBxM5A5",
+		},
+		"encode with padding": {
+			target:   "/encode?n=1000&base=62&digits=5&pad=true",
+			expected: "000g8",
+		},
+		"encode with alphabet": {
+			target:   "/encode?n=1000&base=32&alphabet=crockford",
+			expected: "Z8",
+		},
+		"encode number larger than max uint64": {
+			target:   "/encode?n=99999999999999999999999999&base=62",
+			expected: "83VzM9Rm5CeSkVN",
+		},
+		"decode": {
+			target:   "/decode?s=hBxM5A5&base=62",
+			expected: "1000000000001",
+		},
+		"decode number larger than max uint64": {
+			target:   "/decode?s=83VzM9Rm5CeSkVN&base=62",
+			expected: "99999999999999999999999999",
+		},
+	}
+
+	srv := httptest.NewServer(NewHandler(DefaultLimits))
+	defer srv.Close()
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + test.target)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status %d not equal to expected %d", resp.StatusCode, http.StatusOK)
+			}
+			res := Result{}
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res.Output != test.expected {
+				t.Errorf("result %s not equal to expected %s", res.Output, test.expected)
+			}
+		})
+	}
+}
+
+func TestGetWithError(t *testing.T) {
+	type testCase struct {
+		target         string
+		expectedStatus int
+		expectedCode   string
+	}
+
+	tests := map[string]testCase{
+		"missing base": {
+			target:         "/encode?n=1",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_parameter",
+		},
+		"base exceeds alphabet size": {
+			target:         "/encode?n=1&base=63",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_parameter",
+		},
+		"unknown alphabet": {
+			target:         "/encode?n=1&base=10&alphabet=unknown",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_parameter",
+		},
+		"digits exceeds limit": {
+			target:         "/encode?n=1&base=62&digits=100000000000&pad=true",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_parameter",
+		},
+		"missing input": {
+			target:         "/decode?base=62",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "missing_parameter",
+		},
+		"negative input": {
+			target:         "/encode?n=-1&base=62",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_input",
+		},
+		"input exceeds digits": {
+			target:         "/encode?n=1000&base=10&digits=3",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_input",
+		},
+		"character not in alphabet": {
+			target:         "/decode?s=a@c&base=62",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_input",
+		},
+		"input too large": {
+			target:         "/decode?s=" + strings.Repeat("a", 2000) + "&base=62",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "input_too_large",
+		},
+		"unknown path": {
+			target:         "/convert?n=1&base=62",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	srv := httptest.NewServer(NewHandler(DefaultLimits))
+	defer srv.Close()
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + test.target)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.expectedStatus {
+				t.Fatalf("status %d not equal to expected %d", resp.StatusCode, test.expectedStatus)
+			}
+			if test.expectedCode == "" {
+				return
+			}
+			res := errorResponse{}
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res.Error == nil || res.Error.Code != test.expectedCode {
+				t.Errorf("error %+v does not have expected code %s", res.Error, test.expectedCode)
+			}
+		})
+	}
+}
+
+func TestPost(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(DefaultLimits))
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL+"/encode?base=62", "application/json", strings.NewReader(`["1000000000001", "x", "62"]`))
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d not equal to expected %d", resp.StatusCode, http.StatusOK)
+	}
+
+	res := BatchResult{}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if len(res.Results) != 3 {
+		t.Fatalf("number of results %d not equal to expected %d", len(res.Results), 3)
+	}
+	if res.Results[0].Output != "hBxM5A5" {
+		t.Errorf("result %s not equal to expected %s", res.Results[0].Output, "hBxM5A5")
+	}
+	if res.Results[1].Error == nil || res.Results[1].Error.Code != "invalid_input" {
+		t.Errorf("result %+v does not have expected error code %s", res.Results[1], "invalid_input")
+	}
+	if res.Results[2].Output != "10" {
+		t.Errorf("result %s not equal to expected %s", res.Results[2].Output, "10")
+	}
+}
+
+func TestPostWithError(t *testing.T) {
+	type testCase struct {
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}
+
+	limits := Limits{
+		MaxInputLen:  10,
+		MaxBatchLen:  2,
+		MaxBodyBytes: 64,
+	}
+
+	tests := map[string]testCase{
+		"body not a JSON array": {
+			body:           `{"n": "1"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_json",
+		},
+		"trailing data after array": {
+			body:           `["1"] ["2"]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_json",
+		},
+		"trailing bracket after array": {
+			body:           `["1"]]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_json",
+		},
+		"batch too large": {
+			body:           `["1", "2", "3"]`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedCode:   "batch_too_large",
+		},
+		"body too large": {
+			body:           `["` + strings.Repeat("1", 100) + `"]`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedCode:   "body_too_large",
+		},
+	}
+
+	srv := httptest.NewServer(NewHandler(limits))
+	defer srv.Close()
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Post(srv.URL+"/decode?base=62", "application/json", strings.NewReader(test.body))
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.expectedStatus {
+				t.Fatalf("status %d not equal to expected %d", resp.StatusCode, test.expectedStatus)
+			}
+			res := errorResponse{}
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if res.Error == nil || res.Error.Code != test.expectedCode {
+				t.Errorf("error %+v does not have expected code %s", res.Error, test.expectedCode)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/encode?n=1&base=62", nil)
+	NewHandler(DefaultLimits).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status %d not equal to expected %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+	if allow := rec.Header().Get("Allow"); allow != "GET, POST" {
+		t.Errorf("Allow header %s not equal to expected %s", allow, "GET, POST")
+	}
+}
@@ -0,0 +1,146 @@
+package shortener
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// base is the base in which slugs are encoded
+const base = 62
+
+// maxBodyBytes is the maximum size of the body of a request to shorten a URL
+const maxBodyBytes = 1 << 16
+
+// maxAttempts is the number of keys tried when storing a URL before failing due to collisions
+const maxAttempts = 10
+
+// Handler is an http.Handler that shortens URLs to slugs and redirects slugs to their URLs
+//
+//	POST /       stores the URL in the form value url and responds with its slug
+//	GET  /SLUG   redirects to the URL stored for the slug
+type Handler struct {
+	store   Store
+	codec   *baseconv.Codec
+	baseURL string
+}
+
+// NewHandler creates a Handler issuing slugs padded to the specified number of base 62 digits,
+// reported as a short URL relative to baseURL
+func NewHandler(store Store, digits uint64, baseURL string) (*Handler, error) {
+	if digits == 0 {
+		return nil, errors.New("number of digits must be greater than zero")
+	}
+	codec, err := baseconv.NewCodec(base, alphabet.Default)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{
+		store:   store,
+		codec:   codec.WithWidth(digits).WithPadding(true),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+// Response is the response to a request to shorten a URL
+type Response struct {
+	Slug     string `json:"slug"`
+	ShortURL string `json:"short_url"`
+	URL      string `json:"url"`
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/":
+		h.shorten(w, r)
+	case r.Method == http.MethodGet && r.URL.Path != "/":
+		h.redirect(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) shorten(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	if err := r.ParseForm(); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, fmt.Sprintf("request body exceeds limit [%d] bytes", maxBodyBytes), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, fmt.Sprintf("invalid form: %v", err), http.StatusBadRequest)
+		return
+	}
+	target := r.FormValue("url")
+	u, err := url.Parse(target)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		http.Error(w, fmt.Sprintf("invalid url [%s]", target), http.StatusBadRequest)
+		return
+	}
+
+	slug, err := h.put(target)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(Response{
+		Slug:     slug,
+		ShortURL: h.baseURL + "/" + slug,
+		URL:      target,
+	})
+}
+
+// put stores a URL under the next key of the store's counter, skipping keys that collide
+// with previously stored URLs, and returns the key's slug
+func (h *Handler) put(target string) (string, error) {
+	for i := 0; i < maxAttempts; i++ {
+		key, err := h.store.Next()
+		if err != nil {
+			return "", err
+		}
+		if key > h.codec.MaxValue() {
+			return "", fmt.Errorf("all slugs with %d digits have been issued", h.codec.Width())
+		}
+
+		err = h.store.Put(key, target)
+		if errors.Is(err, ErrExists) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return h.codec.EncodeToString(key)
+	}
+	return "", fmt.Errorf("could not store url after %d colliding keys", maxAttempts)
+}
+
+func (h *Handler) redirect(w http.ResponseWriter, r *http.Request) {
+	slug := strings.TrimPrefix(r.URL.Path, "/")
+	// slugs are decoded only from their canonical padded form
+	key, err := h.codec.DecodeString(slug)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	target, err := h.store.Get(key)
+	if errors.Is(err, ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, target, http.StatusFound)
+}
@@ -0,0 +1,251 @@
+package shortener
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// client does not follow redirects so that they can be inspected
+var client = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func shorten(t *testing.T, srvURL string, target string) (Response, int) {
+	resp, err := client.PostForm(srvURL+"/", url.Values{"url": {target}})
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	res := Response{}
+	if resp.StatusCode == http.StatusCreated {
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+	}
+	return res, resp.StatusCode
+}
+
+func lookup(t *testing.T, srvURL string, slug string) (string, int) {
+	resp, err := client.Get(srvURL + "/" + slug)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	defer resp.Body.Close()
+	return resp.Header.Get("Location"), resp.StatusCode
+}
+
+func newServer(t *testing.T, store Store, digits uint64) *httptest.Server {
+	h, err := NewHandler(store, digits, "https://short.url/")
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestShortenAndRedirect(t *testing.T) {
+	srv := newServer(t, NewMemoryStore(), 7)
+
+	targets := []string{"https://example.com/a", "https://example.com/b", "https://example.com/a"}
+	expectedSlugs := []string{"0000001", "0000002", "0000003"}
+
+	for i, target := range targets {
+		res, status := shorten(t, srv.URL, target)
+		if status != http.StatusCreated {
+			t.Fatalf("status %d not equal to expected %d", status, http.StatusCreated)
+		}
+		if res.Slug != expectedSlugs[i] {
+			t.Errorf("slug %s not equal to expected %s", res.Slug, expectedSlugs[i])
+		}
+		if res.ShortURL != "https://short.url/"+expectedSlugs[i] {
+			t.Errorf("short url %s not equal to expected %s", res.ShortURL, "https://short.url/"+expectedSlugs[i])
+		}
+
+		location, status := lookup(t, srv.URL, res.Slug)
+		if status != http.StatusFound {
+			t.Fatalf("status %d not equal to expected %d", status, http.StatusFound)
+		}
+		if location != target {
+			t.Errorf("location %s not equal to expected %s", location, target)
+		}
+	}
+}
+
+func TestShortenWithCollisions(t *testing.T) {
+	store := NewMemoryStore()
+	for _, key := range []uint64{1, 2, 4} {
+		if err := store.Put(key, "https://example.com/existing"); err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+	}
+	srv := newServer(t, store, 7)
+
+	expectedSlugs := []string{"0000003", "0000005"}
+	for _, expected := range expectedSlugs {
+		res, status := shorten(t, srv.URL, "https://example.com/new")
+		if status != http.StatusCreated {
+			t.Fatalf("status %d not equal to expected %d", status, http.StatusCreated)
+		}
+		if res.Slug != expected {
+			t.Errorf("slug %s not equal to expected %s", res.Slug, expected)
+		}
+	}
+
+	location, _ := lookup(t, srv.URL, "0000004")
+	if location != "https://example.com/existing" {
+		t.Errorf("location %s of colliding key was overwritten", location)
+	}
+}
+
+func TestShortenWithExhaustedCapacity(t *testing.T) {
+	store := NewMemoryStore()
+	store.counter = 60
+	srv := newServer(t, store, 1)
+
+	res, status := shorten(t, srv.URL, "https://example.com")
+	if status != http.StatusCreated {
+		t.Fatalf("status %d not equal to expected %d", status, http.StatusCreated)
+	}
+	if res.Slug != "Z" {
+		t.Errorf("slug %s not equal to expected %s", res.Slug, "Z")
+	}
+
+	_, status = shorten(t, srv.URL, "https://example.com")
+	if status != http.StatusInternalServerError {
+		t.Errorf("status %d not equal to expected %d", status, http.StatusInternalServerError)
+	}
+}
+
+func TestShortenWithInvalidURL(t *testing.T) {
+	srv := newServer(t, NewMemoryStore(), 7)
+
+	for _, target := range []string{"", "example.com", "ftp://example.com", "https://"} {
+		_, status := shorten(t, srv.URL, target)
+		if status != http.StatusBadRequest {
+			t.Errorf("status %d for url [%s] not equal to expected %d", status, target, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestShortenWithBodyTooLarge(t *testing.T) {
+	srv := newServer(t, NewMemoryStore(), 7)
+
+	target := "https://example.com/" + strings.Repeat("a", maxBodyBytes)
+	_, status := shorten(t, srv.URL, target)
+	if status != http.StatusRequestEntityTooLarge {
+		t.Errorf("status %d not equal to expected %d", status, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestRedirectWithUnknownSlug(t *testing.T) {
+	type testCase struct {
+		slug string
+	}
+
+	tests := map[string]testCase{
+		"slug not issued": {
+			slug: "0000002",
+		},
+		"slug without padding": {
+			slug: "1",
+		},
+		"slug with extra padding": {
+			slug: "00000001",
+		},
+		"slug with characters not in alphabet": {
+			slug: "000000@",
+		},
+		"slug exceeding capacity of digits": {
+			slug: "ZZZZZZZZZZZZ",
+		},
+	}
+
+	srv := newServer(t, NewMemoryStore(), 7)
+	if _, status := shorten(t, srv.URL, "https://example.com"); status != http.StatusCreated {
+		t.Fatalf("status %d not equal to expected %d", status, http.StatusCreated)
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, status := lookup(t, srv.URL, test.slug)
+			if status != http.StatusNotFound {
+				t.Errorf("status %d not equal to expected %d", status, http.StatusNotFound)
+			}
+		})
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "urls.jsonl")
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	srv := newServer(t, store, 7)
+	first, _ := shorten(t, srv.URL, "https://example.com/first")
+	srv.Close()
+	if err := store.Close(); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	// reopen the store to check that stored URLs and the counter are restored
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	defer store.Close()
+	srv = newServer(t, store, 7)
+
+	location, status := lookup(t, srv.URL, first.Slug)
+	if status != http.StatusFound || location != "https://example.com/first" {
+		t.Errorf("lookup of %s after reopening returned status %d and location %s", first.Slug, status, location)
+	}
+	second, _ := shorten(t, srv.URL, "https://example.com/second")
+	if second.Slug != "0000002" {
+		t.Errorf("slug %s not equal to expected %s", second.Slug, "0000002")
+	}
+}
+
+func TestFileStoreWithLongURL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "urls.jsonl")
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	srv := newServer(t, store, 7)
+	// each & is escaped to 3 bytes in the body and to 6 bytes in the stored record
+	target := "https://example.com/?" + strings.Repeat("&", maxBodyBytes/3-20)
+	res, status := shorten(t, srv.URL, target)
+	if status != http.StatusCreated {
+		t.Fatalf("status %d not equal to expected %d", status, http.StatusCreated)
+	}
+	srv.Close()
+	if err := store.Close(); err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	defer store.Close()
+	url, err := store.Get(1)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if url != target {
+		t.Errorf("url of length %d for slug %s not equal to expected url of length %d", len(url), res.Slug, len(target))
+	}
+}
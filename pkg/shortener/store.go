@@ -0,0 +1,158 @@
+package shortener
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+var (
+	// ErrNotFound is returned when no URL is stored for a key
+	ErrNotFound = errors.New("key not found")
+	// ErrExists is returned when a URL is already stored for a key
+	ErrExists = errors.New("key already exists")
+)
+
+// Store persists URLs by integer key and issues keys from a monotonic counter
+type Store interface {
+	// Next returns the next value of the counter, which is greater than every previously returned value
+	Next() (uint64, error)
+	// Put stores a URL for a key, returning ErrExists if a URL is already stored for the key
+	Put(key uint64, url string) error
+	// Get returns the URL stored for a key, returning ErrNotFound if no URL is stored for the key
+	Get(key uint64) (string, error)
+}
+
+// MemoryStore is a Store holding URLs in memory
+type MemoryStore struct {
+	mu      sync.RWMutex
+	urls    map[uint64]string
+	counter uint64
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{urls: map[uint64]string{}}
+}
+
+// Next returns the next value of the counter
+func (s *MemoryStore) Next() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counter++
+	return s.counter, nil
+}
+
+// Put stores a URL for a key
+func (s *MemoryStore) Put(key uint64, url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.urls[key]; ok {
+		return ErrExists
+	}
+	s.urls[key] = url
+	return nil
+}
+
+// Get returns the URL stored for a key
+func (s *MemoryStore) Get(key uint64) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	url, ok := s.urls[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return url, nil
+}
+
+// FileStore is a Store that appends each stored URL to a file of JSON lines and holds
+// all stored URLs in memory; its counter resumes from the largest stored key
+type FileStore struct {
+	mem  *MemoryStore
+	mu   sync.Mutex
+	file *os.File
+}
+
+// maxRecordBytes is the maximum length of a line of the file backing a FileStore, which exceeds the
+// length of the record of any URL accepted by the handler after JSON escaping
+const maxRecordBytes = 1 << 20
+
+// record is a line of the file backing a FileStore
+type record struct {
+	Key uint64 `json:"key"`
+	URL string `json:"url"`
+}
+
+// OpenFileStore creates a FileStore backed by the file at the specified path, loading
+// the URLs stored in the file if it exists
+func OpenFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	mem := NewMemoryStore()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxRecordBytes)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		rec := record{}
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("%s:%d: %v", path, lineNum, err)
+		}
+		mem.urls[rec.Key] = rec.URL
+		if rec.Key > mem.counter {
+			mem.counter = rec.Key
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &FileStore{mem: mem, file: f}, nil
+}
+
+// Next returns the next value of the counter
+func (s *FileStore) Next() (uint64, error) {
+	return s.mem.Next()
+}
+
+// Put stores a URL for a key and appends it to the file
+func (s *FileStore) Put(key uint64, url string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.mem.Get(key); err == nil {
+		return ErrExists
+	}
+
+	line, err := json.Marshal(record{Key: key, URL: url})
+	if err != nil {
+		return err
+	}
+	// a longer line could not be read when reopening the store
+	if len(line) >= maxRecordBytes {
+		return fmt.Errorf("record length [%d] exceeds limit [%d]", len(line), maxRecordBytes)
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+	return s.mem.Put(key, url)
+}
+
+// Get returns the URL stored for a key
+func (s *FileStore) Get(key uint64) (string, error) {
+	return s.mem.Get(key)
+}
+
+// Close closes the file backing the store
+func (s *FileStore) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,225 @@
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// maxBits is the number of bits of a Snowflake ID
+const maxBits = 64
+
+// Twitter is the layout of Twitter Snowflake IDs: an unset sign bit, a 41-bit millisecond
+// timestamp since 2010-11-04, a 10-bit worker and a 12-bit sequence
+var Twitter = Layout{
+	Epoch:        time.UnixMilli(1288834974657),
+	Unit:         time.Millisecond,
+	TimeBits:     41,
+	WorkerBits:   10,
+	SequenceBits: 12,
+}
+
+// Layout describes the bit fields of a Snowflake ID, from most to least significant a
+// timestamp in units since an epoch, a worker and a sequence
+type Layout struct {
+	Epoch        time.Time
+	Unit         time.Duration
+	TimeBits     uint
+	WorkerBits   uint
+	SequenceBits uint
+}
+
+// Parts are the fields of a Snowflake ID
+type Parts struct {
+	Timestamp uint64
+	Worker    uint64
+	Sequence  uint64
+}
+
+// Validate checks that the fields of a layout fit in a Snowflake ID
+func (l Layout) Validate() error {
+	if l.Unit <= 0 {
+		return fmt.Errorf("time unit [%s] must be positive", l.Unit)
+	}
+	if l.TimeBits == 0 {
+		return errors.New("number of timestamp bits must be positive")
+	}
+	if bits := l.TimeBits + l.WorkerBits + l.SequenceBits; bits > maxBits {
+		return fmt.Errorf("number of bits [%d] of layout exceeds %d", bits, maxBits)
+	}
+	return nil
+}
+
+// MaxID returns the largest Snowflake ID of a layout
+func (l Layout) MaxID() uint64 {
+	return mask(l.TimeBits + l.WorkerBits + l.SequenceBits)
+}
+
+// Compose combines fields into a Snowflake ID, returning an error if a field overflows its bits
+func (l Layout) Compose(p Parts) (uint64, error) {
+	if p.Timestamp > mask(l.TimeBits) {
+		return 0, fmt.Errorf("timestamp [%d] exceeds %d bits", p.Timestamp, l.TimeBits)
+	}
+	if p.Worker > mask(l.WorkerBits) {
+		return 0, fmt.Errorf("worker [%d] exceeds %d bits", p.Worker, l.WorkerBits)
+	}
+	if p.Sequence > mask(l.SequenceBits) {
+		return 0, fmt.Errorf("sequence [%d] exceeds %d bits", p.Sequence, l.SequenceBits)
+	}
+	return p.Timestamp<<(l.WorkerBits+l.SequenceBits) | p.Worker<<l.SequenceBits | p.Sequence, nil
+}
+
+// Split separates a Snowflake ID into its fields, returning an error if the ID exceeds the layout
+func (l Layout) Split(id uint64) (Parts, error) {
+	if id > l.MaxID() {
+		return Parts{}, fmt.Errorf("ID [%d] exceeds %d bits", id, l.TimeBits+l.WorkerBits+l.SequenceBits)
+	}
+	return Parts{
+		Timestamp: id >> (l.WorkerBits + l.SequenceBits),
+		Worker:    id >> l.SequenceBits & mask(l.WorkerBits),
+		Sequence:  id & mask(l.SequenceBits),
+	}, nil
+}
+
+// Time returns the time of a timestamp of a layout
+func (l Layout) Time(timestamp uint64) time.Time {
+	return l.Epoch.Add(time.Duration(timestamp) * l.Unit).UTC()
+}
+
+// Timestamp returns the timestamp of a layout at a time, returning an error if the time
+// precedes the epoch or exceeds the timestamp bits
+func (l Layout) Timestamp(t time.Time) (uint64, error) {
+	if t.Before(l.Epoch) {
+		return 0, fmt.Errorf("time %s precedes epoch %s", t.UTC(), l.Epoch.UTC())
+	}
+	ts := uint64(t.Sub(l.Epoch) / l.Unit)
+	if ts > mask(l.TimeBits) {
+		return 0, fmt.Errorf("time %s exceeds %d timestamp bits", t.UTC(), l.TimeBits)
+	}
+	return ts, nil
+}
+
+// Encode converts a Snowflake ID to a string representation in the specified base
+func (l Layout) Encode(id uint64, base uint64, alpha *alphabet.Alphabet) (string, error) {
+	if id > l.MaxID() {
+		return "", fmt.Errorf("ID [%d] exceeds %d bits", id, l.TimeBits+l.WorkerBits+l.SequenceBits)
+	}
+	enc, err := baseconv.FromBase10(id, base)
+	if err != nil {
+		return "", err
+	}
+	return alpha.ToString(enc)
+}
+
+// Decode converts a string representation in the specified base to a Snowflake ID
+func (l Layout) Decode(str string, base uint64, alpha *alphabet.Alphabet) (uint64, error) {
+	if str == "" {
+		return 0, errors.New("cannot decode empty string")
+	}
+	numeric, err := alpha.FromString(str)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range numeric {
+		if n >= base {
+			return 0, fmt.Errorf("cannot convert digit [%d] to base [%d]", n, base)
+		}
+	}
+
+	// compare digits with those of the largest ID before converting to avoid overflow
+	maxDigits, err := baseconv.FromBase10(l.MaxID(), base)
+	if err != nil {
+		return 0, err
+	}
+	for len(numeric) > 1 && numeric[0] == 0 {
+		numeric = numeric[1:]
+	}
+	if len(numeric) > len(maxDigits) {
+		return 0, fmt.Errorf("value of [%s] exceeds the largest ID [%d]", str, l.MaxID())
+	}
+	if len(numeric) == len(maxDigits) {
+		for i := range numeric {
+			if numeric[i] != maxDigits[i] {
+				if numeric[i] > maxDigits[i] {
+					return 0, fmt.Errorf("value of [%s] exceeds the largest ID [%d]", str, l.MaxID())
+				}
+				break
+			}
+		}
+	}
+
+	return baseconv.ToBase10(numeric, base)
+}
+
+// Generator generates increasing Snowflake IDs for a single worker
+type Generator struct {
+	mu      sync.Mutex
+	layout  Layout
+	worker  uint64
+	now     func() time.Time
+	started bool
+	last    uint64
+	seq     uint64
+}
+
+// NewGenerator creates a Generator of IDs of a layout for a worker
+func NewGenerator(layout Layout, worker uint64) (*Generator, error) {
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+	if worker > mask(layout.WorkerBits) {
+		return nil, fmt.Errorf("worker [%d] exceeds %d bits", worker, layout.WorkerBits)
+	}
+	return &Generator{layout: layout, worker: worker, now: time.Now}, nil
+}
+
+// New generates a Snowflake ID greater than every ID previously generated by the Generator,
+// waiting for the next time unit when the sequence of the current time unit is exhausted
+func (g *Generator) New() (uint64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ts, err := g.layout.Timestamp(g.now())
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case !g.started || ts > g.last:
+		g.seq = 0
+	case g.seq < mask(g.layout.SequenceBits):
+		// within the same time unit or after the clock moved backwards, continue the sequence
+		ts = g.last
+		g.seq++
+	default:
+		for ts <= g.last {
+			time.Sleep(g.layout.Unit / 10)
+			ts, err = g.layout.Timestamp(g.now())
+			if err != nil {
+				return 0, err
+			}
+		}
+		g.seq = 0
+	}
+
+	id, err := g.layout.Compose(Parts{Timestamp: ts, Worker: g.worker, Sequence: g.seq})
+	if err != nil {
+		return 0, err
+	}
+	g.started = true
+	g.last = ts
+	return id, nil
+}
+
+// mask returns the largest integer of the specified number of bits
+func mask(bits uint) uint64 {
+	if bits >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bits - 1
+}
@@ -0,0 +1,341 @@
+package snowflake
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+// discord is the layout of Discord Snowflake IDs, whose 10-bit worker combines a 5-bit
+// worker and a 5-bit process
+var discord = Layout{
+	Epoch:        time.UnixMilli(1420070400000),
+	Unit:         time.Millisecond,
+	TimeBits:     42,
+	WorkerBits:   10,
+	SequenceBits: 12,
+}
+
+func TestSplitAndCompose(t *testing.T) {
+	type testCase struct {
+		layout   Layout
+		id       uint64
+		expected Parts
+		time     time.Time
+	}
+
+	tests := map[string]testCase{
+		"twitter": {
+			layout:   Twitter,
+			id:       1212161665011617793,
+			expected: Parts{Timestamp: 289001861813, Worker: 465, Sequence: 1},
+			time:     time.UnixMilli(1577836836470),
+		},
+		"discord": {
+			layout:   discord,
+			id:       175928847299117063,
+			expected: Parts{Timestamp: 41944705796, Worker: 32, Sequence: 7},
+			time:     time.UnixMilli(1462015105796),
+		},
+		"largest discord ID": {
+			layout:   discord,
+			id:       math.MaxUint64,
+			expected: Parts{Timestamp: 1<<42 - 1, Worker: 1<<10 - 1, Sequence: 1<<12 - 1},
+			time:     time.UnixMilli(1420070400000 + 1<<42 - 1),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			parts, err := test.layout.Split(test.id)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if parts != test.expected {
+				t.Errorf("parts %+v not equal to expected %+v", parts, test.expected)
+			}
+			if tm := test.layout.Time(parts.Timestamp); !tm.Equal(test.time) {
+				t.Errorf("time %s not equal to expected %s", tm, test.time)
+			}
+			id, err := test.layout.Compose(parts)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if id != test.id {
+				t.Errorf("ID %d not equal to expected %d", id, test.id)
+			}
+		})
+	}
+}
+
+func TestSplitWithError(t *testing.T) {
+	_, err := Twitter.Split(1 << 63)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestComposeWithError(t *testing.T) {
+	type testCase struct {
+		parts Parts
+	}
+
+	tests := map[string]testCase{
+		"timestamp overflow": {
+			parts: Parts{Timestamp: 1 << 41},
+		},
+		"worker overflow": {
+			parts: Parts{Worker: 1 << 10},
+		},
+		"sequence overflow": {
+			parts: Parts{Sequence: 1 << 12},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := Twitter.Compose(test.parts)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	type testCase struct {
+		layout      Layout
+		shouldError bool
+	}
+
+	tests := map[string]testCase{
+		"twitter": {
+			layout: Twitter,
+		},
+		"discord": {
+			layout: discord,
+		},
+		"no worker bits": {
+			layout: Layout{Unit: time.Second, TimeBits: 32, SequenceBits: 16},
+		},
+		"zero unit": {
+			layout:      Layout{TimeBits: 41, WorkerBits: 10, SequenceBits: 12},
+			shouldError: true,
+		},
+		"no timestamp bits": {
+			layout:      Layout{Unit: time.Millisecond, WorkerBits: 10, SequenceBits: 12},
+			shouldError: true,
+		},
+		"too many bits": {
+			layout:      Layout{Unit: time.Millisecond, TimeBits: 42, WorkerBits: 11, SequenceBits: 12},
+			shouldError: true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			err := test.layout.Validate()
+			if test.shouldError && err == nil {
+				t.Fatal("expected non nil error")
+			}
+			if !test.shouldError && err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodeAndDecode(t *testing.T) {
+	type testCase struct {
+		layout   Layout
+		id       uint64
+		base     uint64
+		expected string
+	}
+
+	tests := map[string]testCase{
+		"base 62": {
+			layout:   discord,
+			id:       175928847299117063,
+			base:     62,
+			expected: "cZKS0Tp37x",
+		},
+		"base 10": {
+			layout:   discord,
+			id:       175928847299117063,
+			base:     10,
+			expected: "175928847299117063",
+		},
+		"largest ID": {
+			layout:   discord,
+			id:       math.MaxUint64,
+			base:     62,
+			expected: "lYGhA16ahyf",
+		},
+		"largest ID in base 57": {
+			layout:   discord,
+			id:       math.MaxUint64,
+			base:     57,
+			expected: "OSiLrEickbS",
+		},
+		"largest ID in base 10": {
+			layout:   discord,
+			id:       math.MaxUint64,
+			base:     10,
+			expected: "18446744073709551615",
+		},
+		"large ID in base 58": {
+			layout:   discord,
+			id:       18446744073709551557,
+			base:     58,
+			expected: "GLubwBAfCrn",
+		},
+		"largest Twitter ID in base 36": {
+			layout:   Twitter,
+			id:       math.MaxInt64,
+			base:     36,
+			expected: "1y2p0ij32e8e7",
+		},
+		"zero": {
+			layout:   Twitter,
+			id:       0,
+			base:     62,
+			expected: "0",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			enc, err := test.layout.Encode(test.id, test.base, alphabet.Default)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if enc != test.expected {
+				t.Errorf("encoding %s not equal to expected %s", enc, test.expected)
+			}
+			id, err := test.layout.Decode(enc, test.base, alphabet.Default)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if id != test.id {
+				t.Errorf("ID %d not equal to expected %d", id, test.id)
+			}
+		})
+	}
+}
+
+func TestDecodeWithError(t *testing.T) {
+	type testCase struct {
+		layout Layout
+		str    string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			layout: Twitter,
+			str:    "",
+		},
+		"character not in alphabet": {
+			layout: Twitter,
+			str:    "cZK-0Tp37x",
+		},
+		"exceeds largest ID with same number of digits": {
+			layout: discord,
+			str:    "lYGhA16ahyg",
+		},
+		"exceeds largest ID with more digits": {
+			layout: discord,
+			str:    "10000000000a",
+		},
+		"exceeds largest twitter ID": {
+			layout: Twitter,
+			str:    "lYGhA16ahyf",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := test.layout.Decode(test.str, 62, alphabet.Default)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	layout := Layout{Epoch: time.UnixMilli(1000), Unit: time.Millisecond, TimeBits: 41, WorkerBits: 10, SequenceBits: 2}
+	g, err := NewGenerator(layout, 5)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+
+	// the clock advances by a millisecond every 10 readings
+	readings := 0
+	g.now = func() time.Time {
+		readings++
+		return time.UnixMilli(1010 + int64(readings/10))
+	}
+
+	expected := []Parts{
+		{Timestamp: 10, Worker: 5, Sequence: 0},
+		{Timestamp: 10, Worker: 5, Sequence: 1},
+		{Timestamp: 10, Worker: 5, Sequence: 2},
+		{Timestamp: 10, Worker: 5, Sequence: 3},
+		{Timestamp: 11, Worker: 5, Sequence: 0},
+	}
+
+	prev := uint64(0)
+	for i, exp := range expected {
+		id, err := g.New()
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if i > 0 && id <= prev {
+			t.Errorf("ID %d not greater than previous %d", id, prev)
+		}
+		prev = id
+		parts, err := layout.Split(id)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if parts != exp {
+			t.Errorf("parts %+v not equal to expected %+v", parts, exp)
+		}
+	}
+
+	// a clock moving backwards continues the sequence of the last time unit
+	g.now = func() time.Time { return time.UnixMilli(1005) }
+	id, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	parts, _ := layout.Split(id)
+	if parts != (Parts{Timestamp: 11, Worker: 5, Sequence: 1}) {
+		t.Errorf("parts %+v not equal to expected %+v", parts, Parts{Timestamp: 11, Worker: 5, Sequence: 1})
+	}
+}
+
+func TestGeneratorWithError(t *testing.T) {
+	_, err := NewGenerator(Twitter, 1<<10)
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+
+	g, err := NewGenerator(Twitter, 1)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	g.now = func() time.Time { return Twitter.Epoch.Add(-time.Millisecond) }
+	_, err = g.New()
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
@@ -0,0 +1,270 @@
+package sqids
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+const (
+	// minAlphabetLen is the minimum number of characters of an alphabet
+	minAlphabetLen = 3
+	// minWordLen is the minimum length of a blocked word
+	minWordLen = 3
+)
+
+//go:embed blocklist.txt
+var defaultBlocklist string
+
+// DefaultAlphabet is the default alphabet of the Sqids specification
+var DefaultAlphabet, _ = alphabet.New("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+
+// DefaultBlocklist returns the words of the default blocklist of the Sqids specification
+func DefaultBlocklist() []string {
+	words := []string{}
+	for _, line := range strings.Split(defaultBlocklist, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words
+}
+
+// Options configure the encoding of a Sqids
+type Options struct {
+	// Alphabet is the alphabet of encoded strings, with nil meaning DefaultAlphabet
+	Alphabet *alphabet.Alphabet
+	// MinLength is the minimum length of encoded strings
+	MinLength uint8
+	// Blocklist are the words that encoded strings cannot contain, with nil meaning DefaultBlocklist
+	Blocklist []string
+}
+
+// Sqids encodes lists of non-negative integers into a single string as specified by Sqids
+type Sqids struct {
+	alphabet  []byte
+	minLength int
+	blocklist []string
+}
+
+// New creates a Sqids from the specified options
+func New(opts Options) (*Sqids, error) {
+	alpha := opts.Alphabet
+	if alpha == nil {
+		alpha = DefaultAlphabet
+	}
+	if alpha.Len() < minAlphabetLen {
+		return nil, fmt.Errorf("alphabet must contain at least %d characters", minAlphabetLen)
+	}
+	blocklist := opts.Blocklist
+	if blocklist == nil {
+		blocklist = DefaultBlocklist()
+	}
+
+	chars := []byte(alpha.String())
+	shuffle(chars)
+	return &Sqids{
+		alphabet:  chars,
+		minLength: int(opts.MinLength),
+		blocklist: filterBlocklist(blocklist, alpha.String()),
+	}, nil
+}
+
+// Encode converts a list of non-negative integers to a single string, with an empty list
+// encoded as an empty string
+func (s *Sqids) Encode(nums []uint64) (string, error) {
+	if len(nums) == 0 {
+		return "", nil
+	}
+	// each attempt rotates the alphabet until an encoding without a blocked word is found
+	for increment := 0; increment <= len(s.alphabet); increment++ {
+		id := s.encode(nums, increment)
+		if !s.isBlocked(id) {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("cannot encode %v without a blocked word", nums)
+}
+
+func (s *Sqids) encode(nums []uint64, increment int) string {
+	alphaLen := uint64(len(s.alphabet))
+
+	offset := len(nums)
+	for i, n := range nums {
+		offset += int(s.alphabet[n%alphaLen]) + i
+	}
+	offset = (offset%len(s.alphabet) + increment) % len(s.alphabet)
+
+	alpha := make([]byte, 0, len(s.alphabet))
+	alpha = append(append(alpha, s.alphabet[offset:]...), s.alphabet[:offset]...)
+	prefix := alpha[0]
+	reverse(alpha)
+
+	id := []byte{prefix}
+	for i, n := range nums {
+		id = append(id, toID(n, alpha[1:])...)
+		if i < len(nums)-1 {
+			id = append(id, alpha[0])
+			shuffle(alpha)
+		}
+	}
+
+	if len(id) < s.minLength {
+		id = append(id, alpha[0])
+		for len(id) < s.minLength {
+			shuffle(alpha)
+			n := s.minLength - len(id)
+			if n > len(alpha) {
+				n = len(alpha)
+			}
+			id = append(id, alpha[:n]...)
+		}
+	}
+	return string(id)
+}
+
+// Decode converts a string to the list of integers it encodes; as in the Sqids specification, an
+// empty string or a string containing a character outside the alphabet is decoded as an empty list
+func (s *Sqids) Decode(id string) ([]uint64, error) {
+	nums := []uint64{}
+	if id == "" {
+		return nums, nil
+	}
+	for i := 0; i < len(id); i++ {
+		if strings.IndexByte(string(s.alphabet), id[i]) == -1 {
+			return nums, nil
+		}
+	}
+
+	offset := strings.IndexByte(string(s.alphabet), id[0])
+	alpha := make([]byte, 0, len(s.alphabet))
+	alpha = append(append(alpha, s.alphabet[offset:]...), s.alphabet[:offset]...)
+	reverse(alpha)
+
+	rest := id[1:]
+	for rest != "" {
+		sep := alpha[0]
+		chunk := rest
+		i := strings.IndexByte(rest, sep)
+		if i != -1 {
+			chunk = rest[:i]
+		}
+		// an empty chunk marks the start of the padding to the minimum length
+		if chunk == "" {
+			break
+		}
+
+		n, err := toNumber(chunk, alpha[1:])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode [%s]: %v", id, err)
+		}
+		nums = append(nums, n)
+
+		if i == -1 {
+			break
+		}
+		shuffle(alpha)
+		rest = rest[i+1:]
+	}
+	return nums, nil
+}
+
+// isBlocked reports whether an encoded string contains a blocked word
+func (s *Sqids) isBlocked(id string) bool {
+	id = strings.ToLower(id)
+	for _, word := range s.blocklist {
+		if len(word) > len(id) {
+			continue
+		}
+		switch {
+		case len(id) <= 3 || len(word) <= 3:
+			// short words are blocked only as an exact match
+			if id == word {
+				return true
+			}
+		case strings.ContainsAny(word, "0123456789"):
+			// words containing digits are blocked only at the start or end
+			if strings.HasPrefix(id, word) || strings.HasSuffix(id, word) {
+				return true
+			}
+		case strings.Contains(id, word):
+			return true
+		}
+	}
+	return false
+}
+
+// filterBlocklist lowercases the words of a blocklist, removing words that are too short
+// or contain characters not in the alphabet
+func filterBlocklist(blocklist []string, chars string) []string {
+	chars = strings.ToLower(chars)
+	filtered := []string{}
+	for _, word := range blocklist {
+		if len(word) < minWordLen {
+			continue
+		}
+		word = strings.ToLower(word)
+		inAlphabet := true
+		for _, c := range word {
+			if !strings.ContainsRune(chars, c) {
+				inAlphabet = false
+				break
+			}
+		}
+		if inAlphabet {
+			filtered = append(filtered, word)
+		}
+	}
+	return filtered
+}
+
+// shuffle permutes an alphabet in place deterministically
+func shuffle(alpha []byte) {
+	n := len(alpha)
+	for i, j := 0, n-1; j > 0; i, j = i+1, j-1 {
+		r := (i*j + int(alpha[i]) + int(alpha[j])) % n
+		alpha[i], alpha[r] = alpha[r], alpha[i]
+	}
+}
+
+func reverse(alpha []byte) {
+	for i, j := 0, len(alpha)-1; i < j; i, j = i+1, j-1 {
+		alpha[i], alpha[j] = alpha[j], alpha[i]
+	}
+}
+
+// toID converts an integer to its representation in the base of the alphabet
+func toID(num uint64, alpha []byte) []byte {
+	base := uint64(len(alpha))
+	id := []byte{}
+	for {
+		id = append([]byte{alpha[num%base]}, id...)
+		num /= base
+		if num == 0 {
+			return id
+		}
+	}
+}
+
+// toNumber converts a representation in the base of the alphabet to its integer
+func toNumber(id string, alpha []byte) (uint64, error) {
+	base := uint64(len(alpha))
+	num := uint64(0)
+	for i := 0; i < len(id); i++ {
+		idx := strings.IndexByte(string(alpha), id[i])
+		if idx == -1 {
+			return 0, fmt.Errorf("character [%c] not found in alphabet", id[i])
+		}
+		if num > (math.MaxUint64-uint64(idx))/base {
+			return 0, errors.New("value overflows uint64")
+		}
+		num = num*base + uint64(idx)
+	}
+	return num, nil
+}
@@ -0,0 +1,254 @@
+package sqids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+)
+
+func mustNew(t *testing.T, opts Options) *Sqids {
+	s, err := New(opts)
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	return s
+}
+
+func equal(a []uint64, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEncodeAndDecode(t *testing.T) {
+	type testCase struct {
+		opts     Options
+		nums     []uint64
+		expected string
+	}
+
+	upper, err := alphabet.New("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]testCase{
+		"simple": {
+			nums:     []uint64{1, 2, 3},
+			expected: "86Rf07",
+		},
+		"single zero": {
+			nums:     []uint64{0},
+			expected: "bM",
+		},
+		"single number": {
+			nums:     []uint64{9},
+			expected: "nJ",
+		},
+		"pair with same first number": {
+			nums:     []uint64{0, 9},
+			expected: "moxr",
+		},
+		"pair with same second number": {
+			nums:     []uint64{9, 0},
+			expected: "m2xn",
+		},
+		"default blocklist": {
+			nums:     []uint64{4572721},
+			expected: "JExTR",
+		},
+		"empty blocklist": {
+			opts:     Options{Blocklist: []string{}},
+			nums:     []uint64{4572721},
+			expected: "aho1e",
+		},
+		"custom blocklist": {
+			opts:     Options{Blocklist: []string{"ArUO"}},
+			nums:     []uint64{100_000},
+			expected: "QyG4",
+		},
+		"custom blocklist blocking substring, prefix and postfix": {
+			opts:     Options{Blocklist: []string{"JSwXFaosAN", "OCjV9JK64o", "rBHf", "79SM", "7tE6"}},
+			nums:     []uint64{1_000_000, 2_000_000},
+			expected: "1aYeB7bRUt",
+		},
+		"lowercase blocklist with uppercase alphabet": {
+			opts:     Options{Alphabet: upper, Blocklist: []string{"sxnzkl"}},
+			nums:     []uint64{1, 2, 3},
+			expected: "IBSHOZ",
+		},
+		"minimum length": {
+			opts:     Options{MinLength: 8},
+			nums:     []uint64{1, 2, 3},
+			expected: "86Rf07xd",
+		},
+		"minimum length of alphabet": {
+			opts:     Options{MinLength: 62},
+			nums:     []uint64{1, 2, 3},
+			expected: "86Rf07xd4zBmiJXQG6otHEbew02c3PWsUOLZxADhCpKj7aVFv9I8RquYrNlSTM",
+		},
+		"minimum length exceeding alphabet": {
+			opts:     Options{MinLength: 65},
+			nums:     []uint64{1, 2, 3},
+			expected: "86Rf07xd4zBmiJXQG6otHEbew02c3PWsUOLZxADhCpKj7aVFv9I8RquYrNlSTMyf1",
+		},
+		"minimum length with pair": {
+			opts:     Options{MinLength: 62},
+			nums:     []uint64{0, 9},
+			expected: "moxr3HqLAK0GsTND6jowfZz3SUx7cQ8aC54Pl1RbIvFXmEJuBMYVeW9yrdOtin",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			s := mustNew(t, test.opts)
+
+			id, err := s.Encode(test.nums)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if id != test.expected {
+				t.Errorf("encoded %s not equal to expected %s", id, test.expected)
+			}
+
+			nums, err := s.Decode(test.expected)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !equal(nums, test.nums) {
+				t.Errorf("decoded %v not equal to expected %v", nums, test.nums)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	lists := [][]uint64{
+		{0, 0, 0, 1, 2, 3, 100, 1_000, 100_000, 1_000_000, math.MaxUint64},
+		{0, 0, 0, 0, 0},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		{math.MaxUint64},
+	}
+
+	for _, minLength := range []uint8{0, 1, 5, 10, 62} {
+		s := mustNew(t, Options{MinLength: minLength})
+		for _, list := range lists {
+			id, err := s.Encode(list)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if len(id) < int(minLength) {
+				t.Errorf("encoded %s shorter than minimum length %d", id, minLength)
+			}
+			nums, err := s.Decode(id)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if !equal(nums, list) {
+				t.Errorf("decoded %v not equal to expected %v", nums, list)
+			}
+		}
+	}
+}
+
+func TestDecodeBlockedIDs(t *testing.T) {
+	blocklist := []string{"86Rf07", "se8ojk", "ARsz1p", "Q8AI49", "5sQRZO"}
+	s := mustNew(t, Options{Blocklist: blocklist})
+
+	for _, id := range blocklist {
+		nums, err := s.Decode(id)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if !equal(nums, []uint64{1, 2, 3}) {
+			t.Errorf("decoded %v not equal to expected %v", nums, []uint64{1, 2, 3})
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s := mustNew(t, Options{})
+
+	id, err := s.Encode([]uint64{})
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if id != "" {
+		t.Errorf("encoded %s not equal to expected empty string", id)
+	}
+
+	nums, err := s.Decode("")
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if len(nums) != 0 {
+		t.Errorf("decoded %v not equal to expected empty list", nums)
+	}
+}
+
+func TestDecodeCharacterNotInAlphabet(t *testing.T) {
+	s := mustNew(t, Options{})
+
+	for _, id := range []string{"*", "86Rf*07", "86Rf07*"} {
+		nums, err := s.Decode(id)
+		if err != nil {
+			t.Fatalf("unexpected non nil error: %v", err)
+		}
+		if nums == nil || len(nums) != 0 {
+			t.Errorf("decoded %v of %s not equal to expected empty list", nums, id)
+		}
+	}
+}
+
+func TestEncodeWithError(t *testing.T) {
+	alpha, err := alphabet.New("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := mustNew(t, Options{Alphabet: alpha, MinLength: 3, Blocklist: []string{"cab", "abc", "bca"}})
+
+	if _, err := s.Encode([]uint64{0}); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestDecodeWithError(t *testing.T) {
+	s := mustNew(t, Options{})
+
+	if _, err := s.Decode("86Rf07Rf07Rf07Rf07Rf07"); err == nil {
+		t.Error("expected non nil error for value overflowing uint64")
+	}
+}
+
+func TestNewWithError(t *testing.T) {
+	alpha, err := alphabet.New("ab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(Options{Alphabet: alpha}); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
+
+func TestFilterBlocklist(t *testing.T) {
+	filtered := filterBlocklist([]string{"yes", "no", "nope", "YES"}, "YESNO")
+	expected := []string{"yes", "yes"}
+	if len(filtered) != len(expected) || filtered[0] != expected[0] || filtered[1] != expected[1] {
+		t.Errorf("filtered %v not equal to expected %v", filtered, expected)
+	}
+}
+
+func TestDefaultBlocklist(t *testing.T) {
+	words := DefaultBlocklist()
+	if len(words) != 560 {
+		t.Errorf("number of words %d not equal to expected %d", len(words), 560)
+	}
+}
@@ -0,0 +1,117 @@
+package ulid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dkaslovsky/baseconv/pkg/alphabet"
+	"github.com/dkaslovsky/baseconv/pkg/baseconv"
+)
+
+// size is the number of bytes of a ULID
+const size = 16
+
+// maxTimestamp is the largest millisecond timestamp of a ULID
+const maxTimestamp = 1<<48 - 1
+
+// crockford is Crockford's base 32 alphabet in which ULIDs are encoded
+var crockford, _ = alphabet.Get("crockford")
+
+// ULID is a universally unique lexicographically sortable identifier: a 48-bit Unix
+// timestamp in milliseconds followed by 80 random bits
+type ULID [size]byte
+
+// Parse parses a ULID from its 26-character encoding, ignoring case
+func Parse(str string) (ULID, error) {
+	u := ULID{}
+	b, err := baseconv.DecodeBytes(strings.ToUpper(str), size, crockford)
+	if err != nil {
+		return u, err
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+// String returns the 26-character encoding of a ULID
+func (u ULID) String() string {
+	return baseconv.EncodeBytes(u[:], crockford)
+}
+
+// Timestamp returns the Unix timestamp in milliseconds of a ULID
+func (u ULID) Timestamp() uint64 {
+	ms := uint64(0)
+	for _, b := range u[:6] {
+		ms = ms<<8 | uint64(b)
+	}
+	return ms
+}
+
+// Time returns the time of the timestamp of a ULID
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(u.Timestamp())).UTC()
+}
+
+// Entropy returns the hexadecimal random bits of a ULID
+func (u ULID) Entropy() string {
+	return hex.EncodeToString(u[6:])
+}
+
+// Generator generates monotonically increasing ULIDs: a ULID generated within the same
+// millisecond as its predecessor increments the random bits of its predecessor
+type Generator struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entropy io.Reader
+	last    ULID
+}
+
+// NewGenerator creates a Generator using the system clock and a cryptographic random source
+func NewGenerator() *Generator {
+	return &Generator{now: time.Now, entropy: rand.Reader}
+}
+
+// New generates a ULID greater than every ULID previously generated by the Generator
+func (g *Generator) New() (ULID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.now().UnixMilli()
+	if ms < 0 || ms > maxTimestamp {
+		return ULID{}, errors.New("time cannot be represented by a ULID timestamp")
+	}
+
+	u := ULID{}
+	if uint64(ms) <= g.last.Timestamp() && g.last != (ULID{}) {
+		// within the same millisecond or after the clock moved backwards, increment the previous ULID
+		u = g.last
+		if !increment(u[6:]) {
+			return ULID{}, errors.New("random bits of ULID overflowed within the same millisecond")
+		}
+	} else {
+		for i := 0; i < 6; i++ {
+			u[i] = byte(uint64(ms) >> (40 - 8*i))
+		}
+		if _, err := io.ReadFull(g.entropy, u[6:]); err != nil {
+			return ULID{}, err
+		}
+	}
+
+	g.last = u
+	return u, nil
+}
+
+// increment adds one to a big-endian integer, returning false on overflow
+func increment(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"upper case": {
+			str: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		"lower case": {
+			str: "01arz3ndektsv4rrffq69g5fav",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			u, err := Parse(test.str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if u.String() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+				t.Errorf("string %s not equal to expected %s", u, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+			}
+			if u.Timestamp() != 1469922850259 {
+				t.Errorf("timestamp %d not equal to expected %d", u.Timestamp(), 1469922850259)
+			}
+			if u.Entropy() != "d6764c61efb99302bd5b" {
+				t.Errorf("entropy %s not equal to expected %s", u.Entropy(), "d6764c61efb99302bd5b")
+			}
+		})
+	}
+}
+
+func TestParseWithError(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			str: "",
+		},
+		"too short": {
+			str: "01ARZ3NDEKTSV4RRFFQ69G5FA",
+		},
+		"too long": {
+			str: "01ARZ3NDEKTSV4RRFFQ69G5FAVX",
+		},
+		"excluded character": {
+			str: "01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		},
+		"overflow": {
+			str: "80000000000000000000000000",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	now := time.UnixMilli(1469922850259)
+	g := &Generator{
+		now:     func() time.Time { return now },
+		entropy: bytes.NewReader(bytes.Repeat([]byte{0x01}, 20)),
+	}
+
+	first, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if first.Timestamp() != 1469922850259 {
+		t.Errorf("timestamp %d not equal to expected %d", first.Timestamp(), 1469922850259)
+	}
+	if first.Entropy() != "01010101010101010101" {
+		t.Errorf("entropy %s not equal to expected %s", first.Entropy(), "01010101010101010101")
+	}
+
+	// same millisecond increments the previous ULID
+	second, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if second.Entropy() != "01010101010101010102" {
+		t.Errorf("entropy %s not equal to expected %s", second.Entropy(), "01010101010101010102")
+	}
+	if second.String() <= first.String() {
+		t.Errorf("ULID %s not greater than previous %s", second, first)
+	}
+
+	// clock moving backwards increments the previous ULID
+	now = now.Add(-time.Second)
+	third, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if third.Timestamp() != 1469922850259 || third.String() <= second.String() {
+		t.Errorf("ULID %s not greater than previous %s", third, second)
+	}
+
+	// next millisecond reads new entropy
+	now = now.Add(time.Second + time.Millisecond)
+	fourth, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if fourth.Timestamp() != 1469922850260 {
+		t.Errorf("timestamp %d not equal to expected %d", fourth.Timestamp(), 1469922850260)
+	}
+	if fourth.Entropy() != "01010101010101010101" {
+		t.Errorf("entropy %s not equal to expected %s", fourth.Entropy(), "01010101010101010101")
+	}
+}
+
+func TestGeneratorWithError(t *testing.T) {
+	type testCase struct {
+		now     time.Time
+		entropy []byte
+	}
+
+	tests := map[string]testCase{
+		"time before epoch": {
+			now:     time.UnixMilli(-1),
+			entropy: bytes.Repeat([]byte{0x01}, 10),
+		},
+		"time exceeds timestamp": {
+			now:     time.UnixMilli(maxTimestamp + 1),
+			entropy: bytes.Repeat([]byte{0x01}, 10),
+		},
+		"insufficient entropy": {
+			now:     time.UnixMilli(1469922850259),
+			entropy: bytes.Repeat([]byte{0x01}, 9),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			g := &Generator{
+				now:     func() time.Time { return test.now },
+				entropy: bytes.NewReader(test.entropy),
+			}
+			_, err := g.New()
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestGeneratorOverflow(t *testing.T) {
+	g := &Generator{
+		now:     func() time.Time { return time.UnixMilli(1469922850259) },
+		entropy: bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)),
+	}
+	_, err := g.New()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	_, err = g.New()
+	if err == nil {
+		t.Fatal("expected non nil error")
+	}
+}
@@ -0,0 +1,91 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// UUID is an RFC 4122 universally unique identifier
+type UUID [16]byte
+
+// Parse parses a UUID in the canonical hyphenated form, or as 32 hexadecimal digits
+func Parse(str string) (UUID, error) {
+	u := UUID{}
+	hexStr := str
+	if len(str) == 36 {
+		if str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+			return u, fmt.Errorf("invalid UUID [%s]", str)
+		}
+		hexStr = strings.ReplaceAll(str, "-", "")
+	}
+	if len(hexStr) != 32 {
+		return u, fmt.Errorf("invalid UUID [%s]", str)
+	}
+	if _, err := hex.Decode(u[:], []byte(hexStr)); err != nil {
+		return u, fmt.Errorf("invalid UUID [%s]: %v", str, err)
+	}
+	return u, nil
+}
+
+// String returns the canonical hyphenated form of a UUID
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// Version returns the version of a UUID
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// NewV4 generates a random version 4 UUID
+func NewV4() (UUID, error) {
+	return newV4(rand.Reader)
+}
+
+// NewV7 generates a version 7 UUID from the current Unix time in milliseconds followed by random bits
+func NewV7() (UUID, error) {
+	return newV7(time.Now(), rand.Reader)
+}
+
+// Time returns the timestamp of a version 7 UUID
+func (u UUID) Time() (time.Time, error) {
+	if u.Version() != 7 {
+		return time.Time{}, fmt.Errorf("UUID version [%d] has no Unix timestamp", u.Version())
+	}
+	ms := int64(binary.BigEndian.Uint64(append([]byte{0, 0}, u[:6]...)))
+	return time.UnixMilli(ms).UTC(), nil
+}
+
+func newV4(r io.Reader) (UUID, error) {
+	u := UUID{}
+	if _, err := io.ReadFull(r, u[:]); err != nil {
+		return u, err
+	}
+	setVersion(&u, 4)
+	return u, nil
+}
+
+func newV7(now time.Time, r io.Reader) (UUID, error) {
+	u := UUID{}
+	if _, err := io.ReadFull(r, u[6:]); err != nil {
+		return u, err
+	}
+	ms := uint64(now.UnixMilli())
+	for i := 0; i < 6; i++ {
+		u[i] = byte(ms >> (40 - 8*i))
+	}
+	setVersion(&u, 7)
+	return u, nil
+}
+
+// setVersion sets the version and the RFC 4122 variant bits of a UUID
+func setVersion(u *UUID, version byte) {
+	u[6] = u[6]&0x0f | version<<4
+	u[8] = u[8]&0x3f | 0x80
+}
@@ -0,0 +1,118 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"canonical form": {
+			str: "0f0e1d2c-3b4a-5968-7766-554433221100",
+		},
+		"upper case": {
+			str: "0F0E1D2C-3B4A-5968-7766-554433221100",
+		},
+		"hexadecimal digits": {
+			str: "0f0e1d2c3b4a59687766554433221100",
+		},
+	}
+
+	expected := UUID{0x0f, 0x0e, 0x1d, 0x2c, 0x3b, 0x4a, 0x59, 0x68, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x00}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			u, err := Parse(test.str)
+			if err != nil {
+				t.Fatalf("unexpected non nil error: %v", err)
+			}
+			if u != expected {
+				t.Errorf("result %s not equal to expected %s", u, expected)
+			}
+			if u.String() != "0f0e1d2c-3b4a-5968-7766-554433221100" {
+				t.Errorf("string %s not equal to expected %s", u, "0f0e1d2c-3b4a-5968-7766-554433221100")
+			}
+		})
+	}
+}
+
+func TestParseWithError(t *testing.T) {
+	type testCase struct {
+		str string
+	}
+
+	tests := map[string]testCase{
+		"empty string": {
+			str: "",
+		},
+		"misplaced hyphens": {
+			str: "0f0e1d2c3-b4a-5968-7766-554433221100",
+		},
+		"non hexadecimal digit": {
+			str: "0f0e1d2c-3b4a-5968-7766-55443322110g",
+		},
+		"too short": {
+			str: "0f0e1d2c-3b4a-5968-7766-5544332211",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(test.str)
+			if err == nil {
+				t.Fatal("expected non nil error")
+			}
+		})
+	}
+}
+
+func TestNewV4(t *testing.T) {
+	u, err := newV4(bytes.NewReader(bytes.Repeat([]byte{0xff}, 16)))
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if u.String() != "ffffffff-ffff-4fff-bfff-ffffffffffff" {
+		t.Errorf("result %s not equal to expected %s", u, "ffffffff-ffff-4fff-bfff-ffffffffffff")
+	}
+	if u.Version() != 4 {
+		t.Errorf("version %d not equal to expected %d", u.Version(), 4)
+	}
+}
+
+func TestNewV7(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_123).UTC()
+	u, err := newV7(now, bytes.NewReader(make([]byte, 10)))
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if u.String() != "018bcfe5-687b-7000-8000-000000000000" {
+		t.Errorf("result %s not equal to expected %s", u, "018bcfe5-687b-7000-8000-000000000000")
+	}
+	if u.Version() != 7 {
+		t.Errorf("version %d not equal to expected %d", u.Version(), 7)
+	}
+	ts, err := u.Time()
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if !ts.Equal(now) {
+		t.Errorf("time %s not equal to expected %s", ts, now)
+	}
+}
+
+func TestTimeWithError(t *testing.T) {
+	u, err := newV4(bytes.NewReader(make([]byte, 16)))
+	if err != nil {
+		t.Fatalf("unexpected non nil error: %v", err)
+	}
+	if _, err := u.Time(); err == nil {
+		t.Fatal("expected non nil error")
+	}
+}